@@ -23,11 +23,6 @@ import (
 // Use the resource version when you need to generate multiple related names
 // using the resource_types parameter.
 func dataName() *schema.Resource {
-	resourceMapsKeys := make([]string, 0, len(ResourceDefinitions))
-	for k := range ResourceDefinitions {
-		resourceMapsKeys = append(resourceMapsKeys, k)
-	}
-
 	return &schema.Resource{
 		ReadContext: dataNameRead,
 		Schema: map[string]*schema.Schema{
@@ -71,6 +66,11 @@ func dataName() *schema.Resource {
 				Computed:    true,
 				Description: "The generated Azure-compliant resource name.",
 			},
+			"slug_is_official": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the slug used in the generated name comes from the official CAF documentation. A warning is raised for the out-of-doc slugs.",
+			},
 			"separator": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -78,6 +78,17 @@ func dataName() *schema.Resource {
 				Default:     "-",
 				Description: "Separator character used between name components (default: \"-\").",
 			},
+			"adapt_separator": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "When true and the resource type does not allow the separator, the name uses a dash when the resource type allows dashes, an underscore when it allows underscores, or no separator, instead of running the words together.",
+			},
+			"separators": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Separator of the name keyed by resource type, e.g. { azurerm_storage_account = \"\" }. Overrides separator and adapt_separator for the resource type, which must be resource_type.",
+			},
 			"clean_input": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -95,9 +106,9 @@ func dataName() *schema.Resource {
 			"resource_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validation.StringInSlice(resourceMapsKeys, false),
+				ValidateFunc: validation.StringIsNotEmpty,
 				ForceNew:     true,
-				Description:  "Azure resource type for name generation (e.g., \"azurerm_storage_account\").",
+				Description:  "Azure resource type for name generation (e.g., \"azurerm_storage_account\"), its CAF slug or its ARM resource type (e.g., \"Microsoft.Storage/storageAccounts\").",
 			},
 			"random_seed": {
 				Type:        schema.TypeInt,
@@ -112,49 +123,179 @@ func dataName() *schema.Resource {
 				Default:     true,
 				Description: "Whether to include the CAF resource type slug/abbreviation in the generated name (default: true).",
 			},
+			"profile": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Name of a profile defined in the provider configuration. Its values, merged with the provider defaults, are used for the arguments that are not set on this data source.",
+			},
+			"random_character_set": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(randomCharacterSets, false),
+				Description:  "Characters of the random segment: lowercase (default), letters, alphanumeric or numeric. Uppercase letters are only generated for the resource types which are not lowercase, and the characters not allowed by the resource type are left out.",
+			},
+			"hash_inputs": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:     true,
+				RequiredWith: []string{"hash_length"},
+				Description:  "Values digested into the hash segment, e.g. the subscription id and the environment. The same values always give the same hash.",
+			},
+			"hash_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				RequiredWith: []string{"hash_inputs"},
+				Description:  "Number of characters of the hash segment, made of the lowercase letters and digits allowed by the resource type.",
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateNameFormat,
+				Description:  "Template laying out the name with placeholders, e.g. \"{env}{slug}{workload}{region?}{instance?|}\". The built-in placeholders are name, slug, random, prefixes and suffixes, the other ones are read from format_values. A placeholder followed by ? is optional, a placeholder followed by |<separator> is joined to the previous segment with that separator.",
+			},
+			"format_values": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				Description: "Values of the custom placeholders of the format.",
+			},
+			"segment_priorities": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Optional:    true,
+				Description: "Priority of the placeholders, e.g. { prefixes = 10 }. When the name exceeds the maximum length, the segments of the lowest priority are dropped first. Placeholders without priority have priority 0 and keep their default order.",
+			},
+			"required_segments": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(placeholderKeyRegEx, "must be a placeholder key"),
+				},
+				Optional:    true,
+				Description: "Placeholders whose segments are always part of the name. An error is returned when they have no value or when they alone exceed the maximum length.",
+			},
+			"shortening": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(shorteningModes, false),
+				Description:  "Strategy applied when the name exceeds the maximum length: truncate (default) drops the segments which do not fit, proportional shortens the name, prefixes, suffixes and custom segments proportionally to their length, vowels removes the vowels of the name, hash replaces the overflowing characters with a 5 characters hash of the whole name.",
+			},
+			"transliterate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Whether to replace the accented and other non-ASCII letters of the inputs with their ASCII spelling before the characters not allowed by the resource type are removed, e.g. \"Société-Générale\" becomes \"Societe-Generale\" instead of \"Socit-Gnrale\". Only applies with clean_input.",
+			},
+			"repairs": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "Changes made to the ends of the name to follow the rules of the resource type, e.g. a leading digit removed for a resource type whose names start with a letter, or a trailing separator left by the truncation.",
+			},
+			"composition": compositionSchema("How the name was composed, a single element for resource_type."),
+			"cleaned_characters": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "Characters of the inputs replaced or removed by clean_input and transliterate, mapped to their replacement, empty when removed.",
+			},
+			"padding": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(paddingModes, false),
+				Description:  "Characters appended to the name when it is shorter than the minimum length of the resource type and refused by its validation regex: hash (default) appends a hash of the name, random appends random characters, seeded by random_seed, none refuses the names too short.",
+			},
+			"case": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(nameCases, false),
+				Description:  "Case of the name: preserve (default) keeps the case of the inputs, lower, upper, pascal capitalizes the words of the prefixes, slug, name, suffixes and custom segments and removes the characters between them, camel does the same but starts with a lowercase letter. The resource types whose naming rules do not allow the letters of the case are refused. The names of the lowercase resource types are always lowercased.",
+			},
 			"error_when_exceeding_max_length": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Default:     false,
 				Description: "When true, returns an error if the generated name exceeds the resource type's maximum length.",
 			},
+			"uniqueness_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(uniquenessPolicies, false),
+				Description:  "Policy applied when the resource type is of global scope, e.g. a storage account, and the random and hash segments of the name carry too little entropy: off (default), warn or fail. Overrides the uniqueness_policy of the provider defaults.",
+			},
+			"rules_version": rulesVersionSchema(false, "Version of the built-in naming rules used to generate the name, e.g. v1, or latest. Overrides the rules_version of the provider."),
+			"collision_probability": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Estimated probability that another name generated from the same inputs is identical, based on the length and the character set of the random and hash segments.",
+			},
 		},
 	}
 }
 
 func dataNameRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	err := getNameReadResult(d, meta)
+	diags, err := getNameReadResult(d, meta)
 	if err != nil {
-		return diag.FromErr(err)
+		return append(diags, diag.FromErr(err)...)
 	}
-	return diag.Diagnostics{}
+	return diags
 }
 
-func getNameReadResult(d *schema.ResourceData, meta interface{}) error {
-	name := d.Get("name").(string)
-	prefixes := convertInterfaceToString(d.Get("prefixes").([]interface{}))
-	suffixes := convertInterfaceToString(d.Get("suffixes").([]interface{}))
-	separator := d.Get("separator").(string)
+// getNameReadResult sets the name and the other computed attributes of the data
+// source. It returns the warnings of the out-of-doc slugs and of the uniqueness policy
+// along with the error.
+func getNameReadResult(d *schema.ResourceData, meta interface{}) (diag.Diagnostics, error) {
+	inputs, err := readNameInputs(d, meta)
+	if err != nil {
+		return nil, err
+	}
 	resourceType := d.Get("resource_type").(string)
-	cleanInput := d.Get("clean_input").(bool)
-	passthrough := d.Get("passthrough").(bool)
-	useSlug := d.Get("use_slug").(bool)
-	randomLength := d.Get("random_length").(int)
-	randomSeed := int64(d.Get("random_seed").(int))
-	errorWhenExceedingMaxLength := d.Get("error_when_exceeding_max_length").(bool)
+	randomSeed := inputs.RandomSeed
 
 	convention := ConventionCafClassic
 
-	randomSuffix := randSeq(int(randomLength), &randomSeed)
+	definitions, err := definitionsFor(meta, inputs.RulesVersion)
+	if err != nil {
+		return nil, err
+	}
+	inputs.AdaptSeparator = d.Get("adapt_separator").(bool)
+	inputs.Separators, err = definitions.resolveSeparators(convertInterfaceMapToString(d.Get("separators").(map[string]interface{})), []string{resourceType})
+	if err != nil {
+		return nil, err
+	}
+	outOfDoc := definitions.outOfDocSlugs([]string{resourceType}, inputs.UseSlug)
+	diags := outOfDocSlugWarnings(outOfDoc)
 
-	namePrecedence := []string{"name", "slug", "random", "suffixes", "prefixes"}
+	resource, err := definitions.getResource(resourceType)
+	if err != nil {
+		return diags, err
+	}
+	collisionProbability, uniqueness := checkUniqueness([]*ResourceStructure{resource}, inputs)
+	if uniqueness.HasError() {
+		return diags, diagnosticsError(uniqueness)
+	}
+	diags = append(diags, uniqueness...)
 
-	resourceName, err := getResourceName(resourceType, separator, prefixes, name, suffixes, randomSuffix, convention, cleanInput, passthrough, useSlug, namePrecedence, errorWhenExceedingMaxLength)
+	randomSuffix, err := randomSuffixFor(resource, inputs.RandomCharacterSet, randomValues(inputs.RandomLength, &randomSeed))
+	if err != nil {
+		return diags, err
+	}
+	generated, err := generateResourceName(resource, inputs, randomSuffix, convention)
 	if err != nil {
-		return err
+		return diags, err
 	}
+	resourceName := generated.Name
 	d.Set("result", resourceName)
+	d.Set("repairs", generated.Repairs)
+	d.Set("composition", []interface{}{flattenNameComposition(resourceType, generated.Composition)})
+	d.Set("cleaned_characters", cleanedCharacters(inputs, resource))
+	d.Set("slug_is_official", !inputs.UseSlug || !resource.OutOfDoc)
+	d.Set("collision_probability", collisionProbability)
 
 	d.SetId(resourceName)
-	return nil
+	return diags, nil
 }
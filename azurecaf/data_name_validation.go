@@ -0,0 +1,105 @@
+package azurecaf
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dataNameValidation creates and returns the schema for the azurecaf_name_validation data source.
+//
+// This data source checks an existing name against the naming rules of a resource type
+// without modifying it. Unlike passthrough mode, which fails on the first regex mismatch,
+// it reports every rule the name does not comply with:
+//   - Length out of the min_length and max_length range
+//   - Disallowed characters, with their positions
+//   - Uppercase characters for the lowercase resource types
+//   - Disallowed first or last character
+//
+// Typical use is gating the import of brownfield resources with a precondition on is_valid.
+func dataNameValidation() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataNameValidationRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name to validate.",
+			},
+			"resource_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Azure resource type whose naming rules are checked (e.g., \"azurerm_storage_account\"), its CAF slug or its ARM resource type.",
+			},
+			"is_valid": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the name complies with every naming rule of the resource type.",
+			},
+			"violations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Naming rules the name does not comply with.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kind": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Kind of violation: too_short, too_long, invalid_character, uppercase, invalid_start, invalid_end or pattern.",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Human readable description of the violation.",
+						},
+						"position": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Zero-based position of the offending character, -1 when the violation is not about a character.",
+						},
+						"character": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Offending character, empty when the violation is not about a character.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataNameValidationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	name := d.Get("name").(string)
+	resourceType := d.Get("resource_type").(string)
+
+	resource, err := definitionsFromMeta(meta).getResource(resourceType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	violations, err := validateName(name, resource)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flattened := make([]interface{}, 0, len(violations))
+	for _, violation := range violations {
+		flattened = append(flattened, map[string]interface{}{
+			"kind":      violation.Kind,
+			"message":   violation.Message,
+			"position":  violation.Position,
+			"character": violation.Character,
+		})
+	}
+	if err := d.Set("violations", flattened); err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("is_valid", len(violations) == 0)
+
+	d.SetId(fmt.Sprintf("%s:%s", resource.ResourceTypeName, name))
+	return nil
+}
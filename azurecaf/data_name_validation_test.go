@@ -0,0 +1,135 @@
+package azurecaf
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestValidateName(t *testing.T) {
+	cases := []struct {
+		name         string
+		resourceType string
+		value        string
+		expected     []nameViolation
+	}{
+		{
+			name:         "valid",
+			resourceType: "azurerm_storage_account",
+			value:        "stmyapp001",
+			expected:     []nameViolation{},
+		},
+		{
+			name:         "too short",
+			resourceType: "azurerm_storage_account",
+			value:        "st",
+			expected:     []nameViolation{{Kind: ViolationTooShort, Position: -1}},
+		},
+		{
+			name:         "too long",
+			resourceType: "azurerm_storage_account",
+			value:        "stabcdefghijklmnopqrstuvwxyz",
+			expected:     []nameViolation{{Kind: ViolationTooLong, Position: -1}},
+		},
+		{
+			name:         "length in characters",
+			resourceType: "azurerm_storage_account",
+			value:        "sté" + strings.Repeat("a", 21),
+			expected:     []nameViolation{{Kind: ViolationInvalidCharacter, Position: 2, Character: "é"}},
+		},
+		{
+			name:         "disallowed characters and case",
+			resourceType: "azurerm_storage_account",
+			value:        "st-My_app",
+			expected: []nameViolation{
+				{Kind: ViolationInvalidCharacter, Position: 2, Character: "-"},
+				{Kind: ViolationUppercase, Position: 3, Character: "M"},
+				{Kind: ViolationInvalidCharacter, Position: 5, Character: "_"},
+			},
+		},
+		{
+			name:         "bad start and end",
+			resourceType: "azurerm_key_vault",
+			value:        "1kv-myapp-",
+			expected: []nameViolation{
+				{Kind: ViolationInvalidStart, Position: 0, Character: "1"},
+				{Kind: ViolationInvalidEnd, Position: 9, Character: "-"},
+			},
+		},
+		{
+			name:         "slug",
+			resourceType: "rg",
+			value:        "rg-myapp",
+			expected:     []nameViolation{},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			resource, err := getResource(tt.resourceType)
+			if err != nil {
+				t.Fatal(err)
+			}
+			violations, err := validateName(tt.value, resource)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(violations) != len(tt.expected) {
+				t.Fatalf("expected %d violations, got %+v", len(tt.expected), violations)
+			}
+			for i, expected := range tt.expected {
+				got := violations[i]
+				if got.Kind != expected.Kind || got.Position != expected.Position || got.Character != expected.Character {
+					t.Errorf("violation %d: expected %+v, got %+v", i, expected, got)
+				}
+				if got.Message == "" {
+					t.Errorf("violation %d has no message", i)
+				}
+			}
+		})
+	}
+}
+
+func TestDataNameValidationRead(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataNameValidation().Schema, map[string]interface{}{
+		"name":          "St_MyApp",
+		"resource_type": "azurerm_storage_account",
+	})
+	if diags := dataNameValidationRead(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if d.Get("is_valid").(bool) {
+		t.Error("expected the name to be invalid")
+	}
+	violations := d.Get("violations").([]interface{})
+	if len(violations) != 4 {
+		t.Fatalf("expected 4 violations, got %v", violations)
+	}
+	first := violations[0].(map[string]interface{})
+	if first["kind"] != ViolationUppercase || first["position"] != 0 || first["character"] != "S" {
+		t.Errorf("unexpected first violation %v", first)
+	}
+	if d.Id() != "azurerm_storage_account:St_MyApp" {
+		t.Errorf("unexpected id %s", d.Id())
+	}
+
+	d = schema.TestResourceDataRaw(t, dataNameValidation().Schema, map[string]interface{}{
+		"name":          "stmyapp",
+		"resource_type": "azurerm_storage_account",
+	})
+	if diags := dataNameValidationRead(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !d.Get("is_valid").(bool) || len(d.Get("violations").([]interface{})) != 0 {
+		t.Errorf("expected a valid name, got %v", d.Get("violations"))
+	}
+
+	d = schema.TestResourceDataRaw(t, dataNameValidation().Schema, map[string]interface{}{
+		"name":          "stmyapp",
+		"resource_type": "azurerm_does_not_exist",
+	})
+	if diags := dataNameValidationRead(context.Background(), d, nil); !diags.HasError() {
+		t.Error("expected an error for an unknown resource type")
+	}
+}
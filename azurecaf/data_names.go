@@ -0,0 +1,32 @@
+package azurecaf
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataNames creates and returns the schema for the azurecaf_names data source.
+//
+// This data source generates the same names as the azurecaf_names resource during the
+// plan phase. Names without random characters, or with a random_seed, are stable;
+// unseeded random characters change on every read, use the resource to keep them.
+func dataNames() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataNamesRead,
+		Schema:      namesSchema(),
+	}
+}
+
+func dataNamesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	results, diags := generateNames(d, meta, nil)
+	if diags.HasError() {
+		return diags
+	}
+	if err := d.Set("results", results); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(randSeq(16, nil))
+	return diags
+}
@@ -0,0 +1,157 @@
+package azurecaf
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var resourceDefinitionLookups = []string{"resource_type", "slug", "arm_type"}
+
+// dataResourceDefinition creates and returns the schema for the azurecaf_resource_definition data source.
+//
+// This data source exposes the naming rules of a resource type at plan time, so that
+// modules can use them in variable validations or to compute their own length budgets.
+// The resource type is looked up by exactly one of:
+//   - resource_type: the azurerm resource type, e.g. azurerm_storage_account
+//   - slug: the CAF slug, e.g. st
+//   - arm_type: the ARM resource type, e.g. Microsoft.Storage/storageAccounts
+//
+// Custom resource definitions of the provider configuration are included.
+func dataResourceDefinition() *schema.Resource {
+	resourceSchema := resourceDefinitionSchema()
+	for _, key := range resourceDefinitionLookups {
+		resourceSchema[key].Optional = true
+		resourceSchema[key].ExactlyOneOf = resourceDefinitionLookups
+		resourceSchema[key].ValidateFunc = validation.StringIsNotEmpty
+	}
+	return &schema.Resource{
+		ReadContext: dataResourceDefinitionRead,
+		Schema:      resourceSchema,
+	}
+}
+
+// resourceDefinitionSchema returns the computed attributes describing a resource definition.
+func resourceDefinitionSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"resource_type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Azure resource type, e.g. azurerm_storage_account.",
+		},
+		"slug": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "CAF slug of the resource type, e.g. st.",
+		},
+		"slug_aliases": {
+			Type:        schema.TypeList,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Computed:    true,
+			Description: "Additional slugs looking up the resource type, they are never part of the names.",
+		},
+		"canonical": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the slug and aliases resolve to this resource type when other types share them.",
+		},
+		"arm_type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "ARM resource type from the CAF documentation, e.g. Microsoft.Storage/storageAccounts, empty when not documented.",
+		},
+		"min_length": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Minimum length of the names.",
+		},
+		"max_length": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Maximum length of the names.",
+		},
+		"lowercase": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the names must be lowercase.",
+		},
+		"regex": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Regular expression matching the characters removed from the inputs.",
+		},
+		"validation_regex": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Regular expression the names must match.",
+		},
+		"dashes": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the names may contain dashes.",
+		},
+		"scope": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Scope the names must be unique in: global, subscription, resourceGroup, region or parent.",
+		},
+		"out_of_doc": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the resource type is missing from the official CAF documentation.",
+		},
+		"official_resource": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Name of the resource in the CAF documentation.",
+		},
+	}
+}
+
+// flattenResourceDefinition returns the attributes of resourceDefinitionSchema for a definition.
+func flattenResourceDefinition(resource *ResourceStructure) map[string]interface{} {
+	return map[string]interface{}{
+		"resource_type":     resource.ResourceTypeName,
+		"slug":              resource.CafPrefix,
+		"slug_aliases":      resource.SlugAliases,
+		"canonical":         resource.Canonical,
+		"arm_type":          resource.Official.ResourceProviderNamespace,
+		"min_length":        resource.MinLength,
+		"max_length":        resource.MaxLength,
+		"lowercase":         resource.LowerCase,
+		"regex":             resource.RegEx,
+		"validation_regex":  resource.ValidationRegExp,
+		"dashes":            resource.Dashes,
+		"scope":             resource.Scope,
+		"out_of_doc":        resource.OutOfDoc,
+		"official_resource": resource.Official.Resource,
+	}
+}
+
+func dataResourceDefinitionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	definitions := definitionsFromMeta(meta)
+
+	var resource *ResourceStructure
+	var err error
+	switch {
+	case d.Get("resource_type").(string) != "":
+		resource, err = definitions.getResource(d.Get("resource_type").(string))
+	case d.Get("slug").(string) != "":
+		resource, err = definitions.getResourceBySlug(d.Get("slug").(string))
+	default:
+		resource, err = definitions.getResourceByArmType(d.Get("arm_type").(string))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	for key, value := range flattenResourceDefinition(resource) {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	d.SetId(resource.ResourceTypeName)
+	return nil
+}
@@ -0,0 +1,87 @@
+package azurecaf
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDataResourceDefinitionRead(t *testing.T) {
+	cases := []struct {
+		name     string
+		raw      map[string]interface{}
+		expected string
+		err      string
+	}{
+		{"resource type", map[string]interface{}{"resource_type": "azurerm_key_vault"}, "azurerm_key_vault", ""},
+		{"slug", map[string]interface{}{"slug": "kv"}, "azurerm_key_vault", ""},
+		{"arm type", map[string]interface{}{"arm_type": "Microsoft.KeyVault/vaults"}, "azurerm_key_vault", ""},
+		{"ambiguous arm type", map[string]interface{}{"arm_type": "Microsoft.Compute/virtualMachines"}, "", "azurerm_linux_virtual_machine, azurerm_virtual_machine"},
+		{"unknown slug", map[string]interface{}{"slug": "doesnotexist"}, "", "no resource type has the slug"},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, dataResourceDefinition().Schema, tt.raw)
+			diags := dataResourceDefinitionRead(context.Background(), d, nil)
+			if tt.err != "" {
+				if !diags.HasError() || !strings.Contains(diags[0].Summary, tt.err) {
+					t.Fatalf("expected an error containing %q, got %v", tt.err, diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if resourceType := d.Get("resource_type").(string); resourceType != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, resourceType)
+			}
+			if d.Get("slug").(string) != "kv" || d.Get("max_length").(int) != 24 || d.Get("scope").(string) != "global" {
+				t.Errorf("unexpected definition slug=%v max_length=%v scope=%v", d.Get("slug"), d.Get("max_length"), d.Get("scope"))
+			}
+			if d.Get("arm_type").(string) != "Microsoft.KeyVault/vaults" || d.Get("out_of_doc").(bool) {
+				t.Errorf("expected the official metadata, got arm_type=%v out_of_doc=%v", d.Get("arm_type"), d.Get("out_of_doc"))
+			}
+		})
+	}
+}
+
+func TestDataResourceDefinitionsRead(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataResourceDefinitions().Schema, map[string]interface{}{
+		"slug_prefix": "kv",
+	})
+	if diags := dataResourceDefinitionsRead(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	resourceTypes := convertInterfaceToString(d.Get("resource_types").([]interface{}))
+	if len(resourceTypes) < 4 || resourceTypes[0] != "azurerm_key_vault" {
+		t.Errorf("expected the key vault types sorted by name, got %v", resourceTypes)
+	}
+	definitions := d.Get("definitions").([]interface{})
+	if len(definitions) != len(resourceTypes) {
+		t.Fatalf("expected %d definitions, got %d", len(resourceTypes), len(definitions))
+	}
+	for _, definition := range definitions {
+		if slug := definition.(map[string]interface{})["slug"].(string); !strings.HasPrefix(slug, "kv") {
+			t.Errorf("unexpected slug %s", slug)
+		}
+	}
+
+	d = schema.TestResourceDataRaw(t, dataResourceDefinitions().Schema, map[string]interface{}{
+		"scope":      "parent",
+		"out_of_doc": true,
+	})
+	if diags := dataResourceDefinitionsRead(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	for _, definition := range d.Get("definitions").([]interface{}) {
+		values := definition.(map[string]interface{})
+		if values["scope"] != "parent" || values["out_of_doc"] != true {
+			t.Errorf("unexpected definition %v", values)
+		}
+	}
+	if len(d.Get("definitions").([]interface{})) == 0 {
+		t.Error("expected out of doc definitions of parent scope")
+	}
+}
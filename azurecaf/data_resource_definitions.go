@@ -0,0 +1,87 @@
+package azurecaf
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dataResourceDefinitions creates and returns the schema for the azurecaf_resource_definitions data source.
+//
+// This data source lists the resource definitions, sorted by resource type, optionally
+// filtered by scope, slug prefix or documentation status. Custom resource definitions
+// of the provider configuration are included.
+func dataResourceDefinitions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataResourceDefinitionsRead,
+		Schema: map[string]*schema.Schema{
+			"scope": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Only list the resource types of this scope, e.g. global.",
+			},
+			"slug_prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Only list the resource types whose slug starts with this prefix.",
+			},
+			"out_of_doc": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "When set, only list the resource types missing from (true) or present in (false) the official CAF documentation.",
+			},
+			"resource_types": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "Names of the listed resource types.",
+			},
+			"definitions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: resourceDefinitionSchema(),
+				},
+				Description: "Naming rules of the listed resource types.",
+			},
+		},
+	}
+}
+
+func dataResourceDefinitionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	scope := d.Get("scope").(string)
+	slugPrefix := d.Get("slug_prefix").(string)
+	filterOutOfDoc := isConfigured(d, "out_of_doc", false)
+	outOfDoc := d.Get("out_of_doc").(bool)
+
+	resourceTypes := []string{}
+	definitions := []interface{}{}
+	for _, resource := range definitionsFromMeta(meta).sorted() {
+		if scope != "" && resource.Scope != scope {
+			continue
+		}
+		if slugPrefix != "" && !strings.HasPrefix(resource.CafPrefix, slugPrefix) {
+			continue
+		}
+		if filterOutOfDoc && resource.OutOfDoc != outOfDoc {
+			continue
+		}
+		resourceTypes = append(resourceTypes, resource.ResourceTypeName)
+		definitions = append(definitions, flattenResourceDefinition(&resource))
+	}
+
+	if err := d.Set("resource_types", resourceTypes); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("definitions", definitions); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s:%s:%t:%t", scope, slugPrefix, filterOutOfDoc, outOfDoc))
+	return nil
+}
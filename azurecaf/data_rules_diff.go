@@ -0,0 +1,124 @@
+package azurecaf
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// dataRulesDiff creates and returns the schema for the azurecaf_rules_diff data source.
+//
+// This data source lists the resource types whose naming rules differ between two
+// versions of the built-in definitions, so that the names affected by moving
+// rules_version forward can be reviewed before the upgrade. Custom resource
+// definitions are not part of the comparison.
+func dataRulesDiff() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataRulesDiffRead,
+		Schema: map[string]*schema.Schema{
+			"from": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(availableRulesVersions(), false),
+				Description:  "Rules version compared from, e.g. v1.",
+			},
+			"to": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      RulesVersionLatest,
+				ValidateFunc: validation.StringInSlice(availableRulesVersions(), false),
+				Description:  "Rules version compared to, defaults to latest.",
+			},
+			"versions": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "Available rules versions, oldest first, followed by latest.",
+			},
+			"added": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "Resource types only defined in the to version.",
+			},
+			"removed": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "Resource types only defined in the from version.",
+			},
+			"changed": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "Resource types whose naming rules differ, their names may change.",
+			},
+			"changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Azure resource type, e.g. azurerm_storage_account.",
+						},
+						"change": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Kind of change: added, removed or changed.",
+						},
+						"details": {
+							Type:        schema.TypeList,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Computed:    true,
+							Description: "Changed attributes of a changed resource type, e.g. max_length: 24 -> 20.",
+						},
+					},
+				},
+				Description: "Every difference between the two versions, sorted by resource type.",
+			},
+		},
+	}
+}
+
+func dataRulesDiffRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	from := d.Get("from").(string)
+	to := d.Get("to").(string)
+	fromSet, err := rulesDefinitionSet(from)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	toSet, err := rulesDefinitionSet(to)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	lists := map[string][]string{"added": {}, "removed": {}, "changed": {}}
+	changes := []interface{}{}
+	for _, change := range diffRules(fromSet, toSet) {
+		lists[change.Change] = append(lists[change.Change], change.ResourceType)
+		changes = append(changes, map[string]interface{}{
+			"resource_type": change.ResourceType,
+			"change":        change.Change,
+			"details":       change.Details,
+		})
+	}
+
+	for key, resourceTypes := range lists {
+		if err := d.Set(key, resourceTypes); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := d.Set("changes", changes); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("versions", availableRulesVersions()); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s:%s", from, to))
+	return nil
+}
@@ -0,0 +1,309 @@
+package azurecaf
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+var _ function.Function = &nameFunction{}
+
+// nameFunction implements provider::azurecaf::name, the function counterpart of the
+// azurecaf_name data source.
+//
+// Usage: provider::azurecaf::name("azurerm_storage_account", "myapp", { prefixes = ["prod"] })
+type nameFunction struct{}
+
+func newNameFunction() function.Function {
+	return &nameFunction{}
+}
+
+func (f *nameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "name"
+}
+
+func (f *nameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Generates an Azure-compliant resource name",
+		MarkdownDescription: "Generates a name for the given resource type, applying the same rules as the `azurecaf_name` data source. " +
+			"The options object accepts `prefixes`, `suffixes`, `separator`, `random_length`, `random_seed`, `random_character_set`, `hash_inputs`, `hash_length`, `clean_input`, " +
+			"`transliterate`, `passthrough`, `use_slug`, `error_when_exceeding_max_length`, `shortening`, `padding`, `case`, `format`, `format_values`, `segment_priorities`, `required_segments` and `rules_version`. Functions must be deterministic, so " +
+			"`random_seed` is required when `random_length` is set.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "resource_type",
+				MarkdownDescription: "Azure resource type, e.g. `azurerm_storage_account`, its CAF slug or its ARM resource type, e.g. `Microsoft.Storage/storageAccounts`.",
+			},
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "Base name of the resource.",
+			},
+			function.DynamicParameter{
+				Name:                "options",
+				AllowNullValue:      true,
+				MarkdownDescription: "Object holding the optional naming arguments, use `{}` or `null` for the defaults.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *nameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var resourceType, name string
+	var options types.Dynamic
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &resourceType, &name, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	inputs, err := nameFunctionInputs(options)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, err.Error())
+		return
+	}
+	inputs.Name = name
+
+	if inputs.RandomLength > 0 && inputs.RandomSeed == 0 {
+		resp.Error = function.NewArgumentFuncError(2, "random_seed must be set when random_length is greater than 0, provider functions must return the same result for the same arguments")
+		return
+	}
+	if inputs.Padding == PaddingRandom && inputs.RandomSeed == 0 {
+		resp.Error = function.NewArgumentFuncError(2, "random_seed must be set when padding is random, provider functions must return the same result for the same arguments")
+		return
+	}
+
+	definitions, err := rulesDefinitionSet(inputs.RulesVersion)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, err.Error())
+		return
+	}
+	resource, err := definitions.getResource(resourceType)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	randomSuffix, err := randomSuffixFor(resource, inputs.RandomCharacterSet, randomValues(inputs.RandomLength, &inputs.RandomSeed))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, err.Error())
+		return
+	}
+	result, err := getResourceNameForDefinition(resource, inputs, randomSuffix, ConventionCafClassic)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// nameFunctionInputs converts the options argument of the name function into naming
+// inputs, starting from the defaults of the azurecaf_name data source.
+func nameFunctionInputs(options types.Dynamic) (nameInputs, error) {
+	inputs := nameInputs{
+		Separator:  "-",
+		CleanInput: true,
+		UseSlug:    true,
+	}
+	if options.IsNull() || options.IsUnderlyingValueNull() {
+		return inputs, nil
+	}
+
+	var attributes map[string]attr.Value
+	switch v := options.UnderlyingValue().(type) {
+	case basetypes.ObjectValue:
+		attributes = v.Attributes()
+	case basetypes.MapValue:
+		attributes = v.Elements()
+	default:
+		return inputs, fmt.Errorf("options must be an object, got %s", options.UnderlyingValue().Type(context.Background()))
+	}
+
+	keys := make([]string, 0, len(attributes))
+	for k := range attributes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var err error
+	for _, key := range keys {
+		value := attributes[key]
+		if value.IsNull() {
+			continue
+		}
+		switch key {
+		case "prefixes":
+			inputs.Prefixes, err = attrValueToStrings(key, value)
+		case "suffixes":
+			inputs.Suffixes, err = attrValueToStrings(key, value)
+		case "separator":
+			inputs.Separator, err = attrValueToString(key, value)
+		case "random_length":
+			var length int64
+			length, err = attrValueToInt64(key, value)
+			if err == nil && length < 0 {
+				err = fmt.Errorf("random_length must be non-negative, got: %d", length)
+			}
+			inputs.RandomLength = int(length)
+		case "random_seed":
+			inputs.RandomSeed, err = attrValueToInt64(key, value)
+		case "random_character_set":
+			inputs.RandomCharacterSet, err = attrValueToString(key, value)
+		case "hash_inputs":
+			inputs.HashInputs, err = attrValueToStrings(key, value)
+		case "hash_length":
+			var length int64
+			length, err = attrValueToInt64(key, value)
+			if err == nil && length < 0 {
+				err = fmt.Errorf("hash_length must be non-negative, got: %d", length)
+			}
+			inputs.HashLength = int(length)
+		case "clean_input":
+			inputs.CleanInput, err = attrValueToBool(key, value)
+		case "transliterate":
+			inputs.Transliterate, err = attrValueToBool(key, value)
+		case "passthrough":
+			inputs.Passthrough, err = attrValueToBool(key, value)
+		case "use_slug":
+			inputs.UseSlug, err = attrValueToBool(key, value)
+		case "error_when_exceeding_max_length":
+			inputs.ErrorWhenExceedingMaxLength, err = attrValueToBool(key, value)
+		case "shortening":
+			inputs.Shortening, err = attrValueToString(key, value)
+			if err == nil && !slices.Contains(shorteningModes, inputs.Shortening) {
+				err = fmt.Errorf("option shortening must be one of %v, got %q", shorteningModes, inputs.Shortening)
+			}
+		case "padding":
+			inputs.Padding, err = attrValueToString(key, value)
+			if err == nil && !slices.Contains(paddingModes, inputs.Padding) {
+				err = fmt.Errorf("option padding must be one of %v, got %q", paddingModes, inputs.Padding)
+			}
+		case "case":
+			inputs.Case, err = attrValueToString(key, value)
+			if err == nil && !slices.Contains(nameCases, inputs.Case) {
+				err = fmt.Errorf("option case must be one of %v, got %q", nameCases, inputs.Case)
+			}
+		case "format":
+			var template string
+			template, err = attrValueToString(key, value)
+			if err == nil {
+				inputs.Format, err = parseNameFormat(template)
+			}
+		case "format_values":
+			inputs.FormatValues, err = attrValueToStringMap(key, value)
+			if err == nil {
+				err = validateFormatValues(inputs.FormatValues)
+			}
+		case "segment_priorities":
+			inputs.SegmentPriorities, err = attrValueToIntMap(key, value)
+		case "required_segments":
+			inputs.RequiredSegments, err = attrValueToStrings(key, value)
+		case "rules_version":
+			inputs.RulesVersion, err = attrValueToString(key, value)
+			if err == nil {
+				_, err = rulesDefinitionSet(inputs.RulesVersion)
+			}
+		default:
+			err = fmt.Errorf("unsupported option %q", key)
+		}
+		if err != nil {
+			return inputs, err
+		}
+	}
+	return inputs, nil
+}
+
+func attrValueToString(key string, value attr.Value) (string, error) {
+	if v, ok := value.(basetypes.StringValue); ok {
+		return v.ValueString(), nil
+	}
+	return "", fmt.Errorf("option %s must be a string", key)
+}
+
+func attrValueToInt64(key string, value attr.Value) (int64, error) {
+	if v, ok := value.(basetypes.NumberValue); ok {
+		number, accuracy := v.ValueBigFloat().Int64()
+		if accuracy == 0 {
+			return number, nil
+		}
+	}
+	return 0, fmt.Errorf("option %s must be a whole number", key)
+}
+
+func attrValueToBool(key string, value attr.Value) (bool, error) {
+	if v, ok := value.(basetypes.BoolValue); ok {
+		return v.ValueBool(), nil
+	}
+	return false, fmt.Errorf("option %s must be a bool", key)
+}
+
+func attrValueToStrings(key string, value attr.Value) ([]string, error) {
+	var elements []attr.Value
+	switch v := value.(type) {
+	case basetypes.TupleValue:
+		elements = v.Elements()
+	case basetypes.ListValue:
+		elements = v.Elements()
+	case basetypes.SetValue:
+		elements = v.Elements()
+	default:
+		return nil, fmt.Errorf("option %s must be a list of strings", key)
+	}
+	values := make([]string, 0, len(elements))
+	for _, element := range elements {
+		s, err := attrValueToString(key, element)
+		if err != nil || s == "" {
+			return nil, fmt.Errorf("option %s must be a list of non empty strings", key)
+		}
+		values = append(values, s)
+	}
+	return values, nil
+}
+
+func attrValueToIntMap(key string, value attr.Value) (map[string]int, error) {
+	var elements map[string]attr.Value
+	switch v := value.(type) {
+	case basetypes.ObjectValue:
+		elements = v.Attributes()
+	case basetypes.MapValue:
+		elements = v.Elements()
+	default:
+		return nil, fmt.Errorf("option %s must be a map of whole numbers", key)
+	}
+	values := make(map[string]int, len(elements))
+	for k, element := range elements {
+		n, err := attrValueToInt64(key, element)
+		if err != nil {
+			return nil, fmt.Errorf("option %s must be a map of whole numbers", key)
+		}
+		values[k] = int(n)
+	}
+	return values, nil
+}
+
+func attrValueToStringMap(key string, value attr.Value) (map[string]string, error) {
+	var elements map[string]attr.Value
+	switch v := value.(type) {
+	case basetypes.ObjectValue:
+		elements = v.Attributes()
+	case basetypes.MapValue:
+		elements = v.Elements()
+	default:
+		return nil, fmt.Errorf("option %s must be a map of strings", key)
+	}
+	values := make(map[string]string, len(elements))
+	for k, element := range elements {
+		s, err := attrValueToString(key, element)
+		if err != nil {
+			return nil, fmt.Errorf("option %s must be a map of strings", key)
+		}
+		values[k] = s
+	}
+	return values, nil
+}
@@ -0,0 +1,218 @@
+package azurecaf
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func runFunction(t *testing.T, f function.Function, arguments ...attr.Value) (attr.Value, *function.FuncError) {
+	t.Helper()
+	definition := &function.DefinitionResponse{}
+	f.Definition(context.Background(), function.DefinitionRequest{}, definition)
+	result, funcErr := definition.Definition.Return.NewResultData(context.Background())
+	if funcErr != nil {
+		t.Fatalf("unexpected error creating the result data: %v", funcErr)
+	}
+	resp := &function.RunResponse{
+		Result: result,
+	}
+	f.Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData(arguments),
+	}, resp)
+	return resp.Result.Value(), resp.Error
+}
+
+func testNameFunctionOptions(t *testing.T, attributes map[string]attr.Value) types.Dynamic {
+	t.Helper()
+	attributeTypes := make(map[string]attr.Type, len(attributes))
+	for k, v := range attributes {
+		attributeTypes[k] = v.Type(context.Background())
+	}
+	object, diags := types.ObjectValue(attributeTypes, attributes)
+	if diags.HasError() {
+		t.Fatalf("unexpected error building the options: %v", diags)
+	}
+	return types.DynamicValue(object)
+}
+
+func TestNameFunction(t *testing.T) {
+	prefixes, _ := types.TupleValue([]attr.Type{types.StringType}, []attr.Value{types.StringValue("prod")})
+
+	tests := []struct {
+		name         string
+		resourceType string
+		baseName     string
+		options      types.Dynamic
+		expected     string
+		wantErr      string
+	}{
+		{
+			name:         "null options",
+			resourceType: "azurerm_resource_group",
+			baseName:     "myapp",
+			options:      types.DynamicNull(),
+			expected:     "rg-myapp",
+		},
+		{
+			name:         "prefixes and separator",
+			resourceType: "azurerm_resource_group",
+			baseName:     "myapp",
+			options: testNameFunctionOptions(t, map[string]attr.Value{
+				"prefixes":  prefixes,
+				"separator": types.StringValue("_"),
+			}),
+			expected: "prod_rg_myapp",
+		},
+		{
+			name:         "clean input",
+			resourceType: "azurerm_storage_account",
+			baseName:     "my-app",
+			options: testNameFunctionOptions(t, map[string]attr.Value{
+				"prefixes": prefixes,
+			}),
+			expected: "prodstmyapp",
+		},
+		{
+			name:         "format",
+			resourceType: "azurerm_resource_group",
+			baseName:     "myapp",
+			options: testNameFunctionOptions(t, map[string]attr.Value{
+				"format":        types.StringValue("{env}{slug}{name}"),
+				"format_values": types.MapValueMust(types.StringType, map[string]attr.Value{"env": types.StringValue("prd")}),
+			}),
+			expected: "prd-rg-myapp",
+		},
+		{
+			name:         "random requires a seed",
+			resourceType: "azurerm_resource_group",
+			baseName:     "myapp",
+			options: testNameFunctionOptions(t, map[string]attr.Value{
+				"random_length": types.NumberValue(big.NewFloat(5)),
+			}),
+			wantErr: "random_seed must be set",
+		},
+		{
+			name:         "unsupported option",
+			resourceType: "azurerm_resource_group",
+			baseName:     "myapp",
+			options: testNameFunctionOptions(t, map[string]attr.Value{
+				"prefix": types.StringValue("prod"),
+			}),
+			wantErr: `unsupported option "prefix"`,
+		},
+		{
+			name:         "pinned rules version",
+			resourceType: "azurerm_resource_group",
+			baseName:     "myapp",
+			options: testNameFunctionOptions(t, map[string]attr.Value{
+				"rules_version": types.StringValue("v1"),
+			}),
+			expected: "rg-myapp",
+		},
+		{
+			name:         "unknown rules version",
+			resourceType: "azurerm_resource_group",
+			baseName:     "myapp",
+			options: testNameFunctionOptions(t, map[string]attr.Value{
+				"rules_version": types.StringValue("v0"),
+			}),
+			wantErr: `rules version "v0" is not available`,
+		},
+		{
+			name:         "random padding requires a seed",
+			resourceType: "azurerm_automation_account",
+			baseName:     "ops",
+			options: testNameFunctionOptions(t, map[string]attr.Value{
+				"padding": types.StringValue("random"),
+			}),
+			wantErr: "random_seed must be set when padding is random",
+		},
+		{
+			name:         "no padding",
+			resourceType: "azurerm_automation_account",
+			baseName:     "o",
+			options: testNameFunctionOptions(t, map[string]attr.Value{
+				"padding":  types.StringValue("none"),
+				"use_slug": types.BoolValue(false),
+			}),
+			wantErr: "o is too short: 1 < 6",
+		},
+		{
+			name:         "transliterate",
+			resourceType: "azurerm_resource_group",
+			baseName:     "Société-Générale",
+			options: testNameFunctionOptions(t, map[string]attr.Value{
+				"transliterate": types.BoolValue(true),
+			}),
+			expected: "rg-Societe-Generale",
+		},
+		{
+			name:         "pascal case",
+			resourceType: "azurerm_api_management_api",
+			baseName:     "orders api",
+			options: testNameFunctionOptions(t, map[string]attr.Value{
+				"case":      types.StringValue("pascal"),
+				"separator": types.StringValue(""),
+			}),
+			expected: "ApimapiOrdersApi",
+		},
+		{
+			name:         "case not allowed by the resource type",
+			resourceType: "azurerm_storage_account",
+			baseName:     "myapp",
+			options: testNameFunctionOptions(t, map[string]attr.Value{
+				"case": types.StringValue("upper"),
+			}),
+			wantErr: "case upper is not allowed for the resource type azurerm_storage_account, its names are lowercase",
+		},
+		{
+			name:         "unknown resource type",
+			resourceType: "azurerm_does_not_exist",
+			baseName:     "myapp",
+			options:      types.DynamicNull(),
+			wantErr:      "invalid resource type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := runFunction(t, newNameFunction(), types.StringValue(tt.resourceType), types.StringValue(tt.baseName), tt.options)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !result.Equal(types.StringValue(tt.expected)) {
+				t.Errorf("expected %q, got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestNameFunction_DeterministicRandom(t *testing.T) {
+	options := testNameFunctionOptions(t, map[string]attr.Value{
+		"random_length": types.NumberValue(big.NewFloat(5)),
+		"random_seed":   types.NumberValue(big.NewFloat(42)),
+	})
+	first, err := runFunction(t, newNameFunction(), types.StringValue("azurerm_resource_group"), types.StringValue("myapp"), options)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := runFunction(t, newNameFunction(), types.StringValue("azurerm_resource_group"), types.StringValue("myapp"), options)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !first.Equal(second) {
+		t.Errorf("expected the same name for the same arguments, got %s and %s", first, second)
+	}
+}
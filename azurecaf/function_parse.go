@@ -0,0 +1,88 @@
+package azurecaf
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &parseFunction{}
+
+// resourceDefinitionAttributeTypes describes the object returned for a resource definition.
+var resourceDefinitionAttributeTypes = map[string]attr.Type{
+	"resource_type":    types.StringType,
+	"slug":             types.StringType,
+	"min_length":       types.Int64Type,
+	"max_length":       types.Int64Type,
+	"lowercase":        types.BoolType,
+	"regex":            types.StringType,
+	"validation_regex": types.StringType,
+	"dashes":           types.BoolType,
+	"scope":            types.StringType,
+}
+
+// parseFunction implements provider::azurecaf::parse, which resolves a resource type
+// or a CAF slug into its naming definition.
+//
+// Usage: provider::azurecaf::parse("st").max_length
+type parseFunction struct{}
+
+func newParseFunction() function.Function {
+	return &parseFunction{}
+}
+
+func (f *parseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse"
+}
+
+func (f *parseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Returns the naming definition of a resource type",
+		MarkdownDescription: "Resolves an Azure resource type, a CAF slug or an ARM resource type and returns its naming definition: `resource_type`, `slug`, " +
+			"`min_length`, `max_length`, `lowercase`, `regex`, `validation_regex`, `dashes` and `scope`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "resource_type",
+				MarkdownDescription: "Azure resource type, e.g. `azurerm_storage_account`, its CAF slug or its ARM resource type, e.g. `Microsoft.Storage/storageAccounts`.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: resourceDefinitionAttributeTypes,
+		},
+	}
+}
+
+func (f *parseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var resourceType string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &resourceType))
+	if resp.Error != nil {
+		return
+	}
+
+	resource, err := getResource(resourceType)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, diags := types.ObjectValue(resourceDefinitionAttributeTypes, map[string]attr.Value{
+		"resource_type":    types.StringValue(resource.ResourceTypeName),
+		"slug":             types.StringValue(resource.CafPrefix),
+		"min_length":       types.Int64Value(int64(resource.MinLength)),
+		"max_length":       types.Int64Value(int64(resource.MaxLength)),
+		"lowercase":        types.BoolValue(resource.LowerCase),
+		"regex":            types.StringValue(resource.RegEx),
+		"validation_regex": types.StringValue(resource.ValidationRegExp),
+		"dashes":           types.BoolValue(resource.Dashes),
+		"scope":            types.StringValue(resource.Scope),
+	})
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
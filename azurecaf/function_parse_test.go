@@ -0,0 +1,36 @@
+package azurecaf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestParseFunction(t *testing.T) {
+	for _, resourceType := range []string{"azurerm_storage_account", "st"} {
+		result, err := runFunction(t, newParseFunction(), types.StringValue(resourceType))
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %v", resourceType, err)
+		}
+		object, ok := result.(basetypes.ObjectValue)
+		if !ok {
+			t.Fatalf("expected an object, got %T", result)
+		}
+		attributes := object.Attributes()
+		if !attributes["resource_type"].Equal(types.StringValue("azurerm_storage_account")) {
+			t.Errorf("unexpected resource_type %s", attributes["resource_type"])
+		}
+		if !attributes["max_length"].Equal(types.Int64Value(24)) {
+			t.Errorf("unexpected max_length %s", attributes["max_length"])
+		}
+		if !attributes["lowercase"].Equal(types.BoolValue(true)) {
+			t.Errorf("unexpected lowercase %s", attributes["lowercase"])
+		}
+	}
+
+	_, err := runFunction(t, newParseFunction(), types.StringValue("azurerm_does_not_exist"))
+	if err == nil {
+		t.Error("expected an error for an unknown resource type")
+	}
+}
@@ -0,0 +1,64 @@
+package azurecaf
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &validateFunction{}
+
+// validateFunction implements provider::azurecaf::validate, which checks an existing
+// name against the validation regular expression of a resource type.
+//
+// Usage: provider::azurecaf::validate("azurerm_storage_account", "stmyapp001")
+type validateFunction struct{}
+
+func newValidateFunction() function.Function {
+	return &validateFunction{}
+}
+
+func (f *validateFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate"
+}
+
+func (f *validateFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Checks a name against the naming rules of a resource type",
+		MarkdownDescription: "Returns `true` when the name matches the validation regular expression of the given resource type, `false` otherwise.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "resource_type",
+				MarkdownDescription: "Azure resource type, e.g. `azurerm_storage_account`, its CAF slug or its ARM resource type, e.g. `Microsoft.Storage/storageAccounts`.",
+			},
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "Name to validate.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *validateFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var resourceType, name string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &resourceType, &name))
+	if resp.Error != nil {
+		return
+	}
+
+	resource, err := getResource(resourceType)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	validationRegEx, err := regexp.Compile(resource.ValidationRegExp)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, validationRegEx.MatchString(name)))
+}
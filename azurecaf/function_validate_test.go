@@ -0,0 +1,36 @@
+package azurecaf
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateFunction(t *testing.T) {
+	tests := []struct {
+		resourceType string
+		name         string
+		expected     bool
+	}{
+		{"azurerm_storage_account", "stmyapp001", true},
+		{"azurerm_storage_account", "st-my-app", false},
+		{"st", "stmyapp001", true},
+		{"azurerm_key_vault", "kv-myapp", true},
+		{"azurerm_key_vault", "1kv", false},
+	}
+
+	for _, tt := range tests {
+		result, err := runFunction(t, newValidateFunction(), types.StringValue(tt.resourceType), types.StringValue(tt.name))
+		if err != nil {
+			t.Fatalf("unexpected error for %s %s: %v", tt.resourceType, tt.name, err)
+		}
+		if !result.Equal(types.BoolValue(tt.expected)) {
+			t.Errorf("expected %t for %s %s, got %s", tt.expected, tt.resourceType, tt.name, result)
+		}
+	}
+
+	_, err := runFunction(t, newValidateFunction(), types.StringValue("azurerm_does_not_exist"), types.StringValue("name"))
+	if err == nil {
+		t.Error("expected an error for an unknown resource type")
+	}
+}
@@ -51,7 +51,7 @@ func TestAcc_ResourceTypeBatch(t *testing.T) {
 				})
 
 				// Execute create function
-				err := nameResource.Create(resourceData, nil)
+				err := diagnosticsError(nameResource.CreateContext(context.Background(), resourceData, nil))
 				if err != nil {
 					t.Errorf("Failed to create name resource for %s: %v", resourceType, err)
 					return
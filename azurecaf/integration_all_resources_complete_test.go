@@ -112,7 +112,7 @@ func testNameResource(t *testing.T, nameResource *schema.Resource, resourceType
 			resourceData := schema.TestResourceDataRaw(t, nameResource.Schema, testCase)
 
 			// Execute create function
-			err := nameResource.Create(resourceData, nil)
+			err := diagnosticsError(nameResource.CreateContext(context.Background(), resourceData, nil))
 			if err != nil {
 				t.Errorf("Failed to create name resource for %s with config %d: %v", resourceType, i+1, err)
 				return
@@ -1,6 +1,7 @@
 package azurecaf
 
 import (
+	"context"
 	"strings"
 	"testing"
 
@@ -53,7 +54,7 @@ func TestAcc_ErrorHandling(t *testing.T) {
 		})
 
 		// Try to create the resource - should fail validation
-		err := nameResource.Create(resourceData, nil)
+		err := diagnosticsError(nameResource.CreateContext(context.Background(), resourceData, nil))
 		if err == nil {
 			t.Error("Expected error for excessive random length, but got none")
 		}
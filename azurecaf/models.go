@@ -6,7 +6,13 @@
 package azurecaf
 
 import (
+	cryptorand "crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/big"
 	"math/rand"
+	"regexp"
 	"time"
 )
 
@@ -57,9 +63,6 @@ const (
 
 	// invsqldb defines invalid characters for SQL Database resources
 	invsqldb string = "[<>*%&:\\/?]"
-
-	//Need to find a way to filter beginning and end of string
-	//alphanumstartletter string = "\\A[^a-z][^0-9A-Za-z]"
 )
 
 const (
@@ -81,74 +84,221 @@ type ResourceStructure struct {
 	// Regular expression to apply to the resource type
 	RegEx string `json:"regex,omitempty"`
 	// the Regular expression to validate the generated string
-	ValidationRegExp string `json:"validatation_regex,omitempty"`
+	ValidationRegExp string `json:"validation_regex,omitempty"`
 	// can the resource include dashes
 	Dashes bool `json:"dashes"`
 	// The scope of this name where it needs to be unique
 	Scope string `json:"scope,omitempty"`
+	// the resource type is not listed in the official CAF documentation
+	OutOfDoc bool `json:"out_of_doc,omitempty"`
+	// Attributes from the official CAF documentation
+	Official OfficialData `json:"official"`
+	// additional slugs looking up the resource type, never part of the names
+	SlugAliases []string `json:"slug_aliases,omitempty"`
+	// the slug and aliases resolve to this resource type when other types share them
+	Canonical bool `json:"canonical,omitempty"`
+}
+
+// OfficialData holds the attributes of a resource type in the official CAF documentation.
+type OfficialData struct {
+	// CAF abbreviation, only set for the documented resource types
+	Slug string `json:"slug,omitempty"`
+	// Resource name in the CAF documentation
+	Resource string `json:"resource"`
+	// ARM resource type, e.g. Microsoft.Storage/storageAccounts
+	ResourceProviderNamespace string `json:"resource_provider_namespace,omitempty"`
 }
 
 var (
 	alphagenerator = []rune("abcdefghijklmnopqrstuvwxyz")
+	uppergenerator = []rune("ABCDEFGHIJKLMNOPQRSTUVWXYZ")
+	digitgenerator = []rune("0123456789")
+	hashgenerator  = []rune("0123456789abcdefghijklmnopqrstuvwxyz")
 )
 
-// Generate a random value to add to the resource names
-func randSeq(length int, seed *int64) string {
-	// Handle invalid input: negative or zero length
-	if length <= 0 {
+// allowedCharacters returns the candidate characters kept by the cleaning regex of a
+// resource type, in the order of the candidates.
+func allowedCharacters(resource *ResourceStructure, candidates []rune) []rune {
+	cleaningRegEx, err := regexp.Compile(resource.RegEx)
+	if err != nil {
+		return candidates
+	}
+	allowed := make([]rune, 0, len(candidates))
+	for _, c := range candidates {
+		if !cleaningRegEx.MatchString(string(c)) {
+			allowed = append(allowed, c)
+		}
+	}
+	return allowed
+}
+
+// hashSeq returns a digest of the inputs, encoded with the lowercase letters and
+// digits allowed by the resource type. The same inputs always give the same value.
+func hashSeq(inputs []string, length int, resource *ResourceStructure) string {
+	if length <= 0 || len(inputs) == 0 {
 		return ""
 	}
-	// initialize random seed
-	if seed == nil || *seed == 0 {
-		value := time.Now().UnixNano()
-		seed = &value
+	return hashWithAlphabet(inputs, length, allowedCharacters(resource, hashgenerator))
+}
+
+// hashWithAlphabet returns a digest of the inputs encoded with the characters of alphabet.
+func hashWithAlphabet(inputs []string, length int, alphabet []rune) string {
+	if length <= 0 || len(alphabet) == 0 {
+		return ""
 	}
-	rand.Seed(*seed)
-	// generate at least one random character
+
+	// Inputs are length prefixed so that ["ab", "c"] and ["a", "bc"] differ
+	h := sha256.New()
+	for _, input := range inputs {
+		fmt.Fprintf(h, "%d:%s;", len(input), input)
+	}
+	digest := h.Sum(nil)
+
+	base := big.NewInt(int64(len(alphabet)))
+	value := new(big.Int).SetBytes(digest)
+	remainder := new(big.Int)
 	b := make([]rune, length)
 	for i := range b {
-		// We need the random generated string to start with a letter
-		b[i] = alphagenerator[rand.Intn(len(alphagenerator)-1)]
+		// Extend the digest when its entropy is exhausted
+		if value.Cmp(base) < 0 {
+			sum := sha256.Sum256(digest)
+			digest = sum[:]
+			value.SetBytes(digest)
+		}
+		value.DivMod(value, base, remainder)
+		b[i] = alphabet[remainder.Int64()]
 	}
 	return string(b)
 }
 
+// Character sets of the random characters, the characters not allowed by the
+// resource type are left out.
+const (
+	// RandomCharactersLowercase generates lowercase letters, the historical behavior
+	RandomCharactersLowercase string = "lowercase"
+	// RandomCharactersLetters generates letters, uppercase ones included when the resource type is not lowercase
+	RandomCharactersLetters string = "letters"
+	// RandomCharactersAlphanumeric generates letters and digits
+	RandomCharactersAlphanumeric string = "alphanumeric"
+	// RandomCharactersNumeric generates digits
+	RandomCharactersNumeric string = "numeric"
+)
+
+var randomCharacterSets = []string{RandomCharactersLowercase, RandomCharactersLetters, RandomCharactersAlphanumeric, RandomCharactersNumeric}
+
+// randomValues returns uniformly distributed values, read from crypto/rand when no
+// seed is set, or from a dedicated generator seeded with seed. The global math/rand
+// source is never used.
+func randomValues(length int, seed *int64) []uint64 {
+	if length <= 0 {
+		return nil
+	}
+	values := make([]uint64, length)
+	if seed != nil && *seed != 0 {
+		generator := rand.New(rand.NewSource(*seed))
+		for i := range values {
+			values[i] = generator.Uint64()
+		}
+		return values
+	}
+	buffer := make([]byte, 8*length)
+	if _, err := cryptorand.Read(buffer); err != nil {
+		// crypto/rand does not fail on supported platforms, fall back to a time seeded generator
+		generator := rand.New(rand.NewSource(time.Now().UnixNano()))
+		for i := range values {
+			values[i] = generator.Uint64()
+		}
+		return values
+	}
+	for i := range values {
+		values[i] = binary.BigEndian.Uint64(buffer[8*i:])
+	}
+	return values
+}
+
+// encodeRandomValues maps each random value to a character of the alphabet. The
+// modulo bias is negligible for 64 bits values and alphabets of less than 100 characters.
+func encodeRandomValues(values []uint64, alphabet []rune) string {
+	if len(alphabet) == 0 {
+		return ""
+	}
+	b := make([]rune, len(values))
+	for i, value := range values {
+		b[i] = alphabet[value%uint64(len(alphabet))]
+	}
+	return string(b)
+}
+
+// randomCharacters returns the characters of a character set allowed by a resource type.
+func randomCharacters(characterSet string, resource *ResourceStructure) ([]rune, error) {
+	var candidates []rune
+	switch characterSet {
+	case "", RandomCharactersLowercase:
+		candidates = alphagenerator
+	case RandomCharactersLetters:
+		candidates = append(candidates, alphagenerator...)
+		if !resource.LowerCase {
+			candidates = append(candidates, uppergenerator...)
+		}
+	case RandomCharactersAlphanumeric:
+		candidates = append(candidates, alphagenerator...)
+		if !resource.LowerCase {
+			candidates = append(candidates, uppergenerator...)
+		}
+		candidates = append(candidates, digitgenerator...)
+	case RandomCharactersNumeric:
+		candidates = digitgenerator
+	default:
+		return nil, fmt.Errorf("invalid random character set %q, expected one of %v", characterSet, randomCharacterSets)
+	}
+	allowed := allowedCharacters(resource, candidates)
+	if len(allowed) == 0 {
+		return nil, fmt.Errorf("the random character set %s has no character allowed by the resource type %s", characterSet, resource.ResourceTypeName)
+	}
+	return allowed, nil
+}
+
+// Generate a random value to add to the resource names
+func randSeq(length int, seed *int64) string {
+	return encodeRandomValues(randomValues(length, seed), alphagenerator)
+}
+
 // Resources currently supported
 var Resources = map[string]ResourceStructure{
-	"aaa":    {"azure automation account", "aaa", 6, 50, false, alphanumh, "^[a-zA-Z][0-9A-Za-z-]{5,49}$", true, "resourceGroup"},
-	"ac":     {"azure container app", "ac", 1, 32, true, alphanumh, "^[a-z0-9][a-z0-9-]{0,30}[a-z0-9]$", true, "resourceGroup"},
-	"ace":    {"azure container app environment", "ace", 1, 60, false, alphanumh, "^[0-9A-Za-z][0-9A-Za-z-]{0,58}[0-9a-zA-Z]$", true, "resourceGroup"},
-	"acr":    {"azure container registry", "acr", 5, 50, true, alphanum, "^[0-9A-Za-z]{5,50}$", true, "resourceGroup"},
-	"afw":    {"azure firewall", "afw", 1, 80, false, alphanumhup, "^[a-zA-Z][0-9A-Za-z_.-]{0,79}$", true, "resourceGroup"},
-	"agw":    {"application gateway", "agw", 1, 80, false, alphanumhup, "^[0-9a-zA-Z][0-9A-Za-z_.-]{0,78}[0-9a-zA-Z_]$", true, "resourceGroup"},
-	"aks":    {"azure kubernetes service", "aks", 1, 63, false, alphanumhu, "^[0-9a-zA-Z][0-9A-Za-z_.-]{0,61}[0-9a-zA-Z]$", true, "resourceGroup"},
-	"aksdns": {"aksdns prefix", "aksdns", 3, 45, false, alphanumh, "^[a-zA-Z][0-9A-Za-z-]{0,43}[0-9a-zA-Z]$", true, "resourceGroup"},
-	"aksnpl": {"aks node pool for Linux", "aksnpl", 2, 12, true, alphanum, "^[a-zA-Z][0-9a-z]{0,11}$", true, "resourceGroup"},
-	"aksnpw": {"aks node pool for Windows", "aksnpw", 2, 6, true, alphanum, "^[a-zA-Z][0-9a-z]{0,5}$", true, "resourceGroup"},
-	"apim":   {"api management", "apim", 1, 50, false, alphanum, "^[a-zA-Z][0-9A-Za-z]{0,49}$", true, "resourceGroup"},
-	"app":    {"web app", "app", 2, 60, false, alphanumh, "^[0-9A-Za-z][0-9A-Za-z-]{0,58}[0-9a-zA-Z]$", true, "resourceGroup"},
-	"appi":   {"application insights", "appi", 1, 260, false, invappi, "^[^%&\\?/. ][^%&\\?/]{0,258}[^%&\\?/. ]$", true, "resourceGroup"},
-	"ase":    {"app service environment", "ase", 2, 36, false, alphanumh, "^[0-9A-Za-z-]{2,36}$", true, "resourceGroup"},
-	"asr":    {"azure site recovery", "asr", 2, 50, false, alphanumh, "^[a-zA-Z][0-9A-Za-z-]{1,49}$", true, "resourceGroup"},
-	"dcr":    {"data collection rule", "dcr", 3, 44, false, alphanumhup, "^[a-zA-Z0-9][a-zA-Z0-9-]{1,42}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"evh":    {"event hub", "evh", 1, 50, false, alphanumh, "^[a-zA-Z][0-9A-Za-z-]{0,48}[0-9a-zA-Z]$", true, "resourceGroup"},
-	"gen":    {"generic", "gen", 1, 24, false, alphanum, "^[0-9a-zA-Z]{1,24}$", true, "resourceGroup"},
-	"kv":     {"keyvault", "kv", 3, 24, true, alphanumh, "^[a-zA-Z][0-9A-Za-z-]{0,22}[0-9a-zA-Z]$", true, "resourceGroup"},
-	"la":     {"loganalytics", "la", 4, 63, false, alphanumh, "^[0-9a-zA-Z][0-9A-Za-z-]{3,61}[0-9a-zA-Z]$", true, "resourceGroup"},
-	"las":    {"log analytics solution", "las", 4, 63, false, alphanumh, "^[0-9a-zA-Z][0-9A-Za-z-]{2,61}[0-9a-zA-Z]$", true, "parent"},
-	"laqp":   {"log analytics query pack", "laqp", 4, 63, false, alphanumh, "^[0-9a-zA-Z][0-9A-Za-z-]{2,61}[0-9a-zA-Z]$", true, "parent"},
-	"nic":    {"network interface card", "nic", 1, 80, false, alphanumhup, "^[0-9a-zA-Z][0-9A-Za-z_.-]{0,78}[0-9a-zA-Z_]$", true, "resourceGroup"},
-	"nsg":    {"network security group", "nsg", 1, 80, false, alphanumhup, "^[0-9a-zA-Z][0-9A-Za-z_.-]{0,78}[0-9a-zA-Z_]$", true, "resourceGroup"},
-	"pip":    {"public ip address", "pip", 1, 80, false, alphanumhup, "^[0-9a-zA-Z][0-9A-Za-z_.-]{0,78}[0-9a-zA-Z_]$", true, "resourceGroup"},
-	"plan":   {"app service plan", "plan", 1, 40, false, alphanumh, "^[0-9A-Za-z-]{1,40}$", true, "resourceGroup"},
-	"rg":     {"resource group", "rg", 1, 80, false, unicode, `^[-\w\._\(\)]{1,80}$`, true, "resourceGroup"},
-	"snet":   {"virtual network subnet", "snet", 1, 80, false, alphanumhup, "^[0-9a-zA-Z][0-9A-Za-z_.-]{0,78}[0-9a-zA-Z_]$", true, "resourceGroup"},
-	"sql":    {"azure sql db server", "sql", 1, 63, true, alphanumh, "^[0-9a-z][0-9a-z-]{0,61}[0-9a-z]$", true, "resourceGroup"},
-	"sqldb":  {"azure sql db", "sqldb", 1, 128, false, invsqldb, "^[^<>*%&:\\/?. ][^<>*%&:\\/?]{0,126}[^<>*%&:\\/?. ]$", true, "resourceGroup"},
-	"st":     {"storage account", "st", 3, 24, true, alphanum, "^[0-9a-z]{3,24}$", true, "resourceGroup"},
-	"vml":    {"virtual machine (linux)", "vml", 1, 64, false, alphanumh, "^[0-9a-zA-Z][0-9A-Za-z_-]{0,62}[0-9a-zA-Z_]$", true, "resourceGroup"},
-	"vmw":    {"virtual machine (windows)", "vmw", 1, 15, false, alphanumh, "^[0-9a-zA-Z][0-9A-Za-z_-]{0,13}[0-9a-zA-Z_]$", true, "resourceGroup"},
-	"vnet":   {"virtual network", "vnet", 2, 64, false, alphanumhup, "^[0-9a-zA-Z][0-9A-Za-z_.-]{0,62}[0-9a-zA-Z_]$", true, "resourceGroup"},
+	"aaa":    {"azure automation account", "aaa", 6, 50, false, alphanumh, "^[a-zA-Z][0-9A-Za-z-]{5,49}$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"ac":     {"azure container app", "ac", 1, 32, true, alphanumh, "^[a-z0-9][a-z0-9-]{0,30}[a-z0-9]$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"ace":    {"azure container app environment", "ace", 1, 60, false, alphanumh, "^[0-9A-Za-z][0-9A-Za-z-]{0,58}[0-9a-zA-Z]$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"acr":    {"azure container registry", "acr", 5, 50, true, alphanum, "^[0-9A-Za-z]{5,50}$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"afw":    {"azure firewall", "afw", 1, 80, false, alphanumhup, "^[a-zA-Z][0-9A-Za-z_.-]{0,79}$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"agw":    {"application gateway", "agw", 1, 80, false, alphanumhup, "^[0-9a-zA-Z][0-9A-Za-z_.-]{0,78}[0-9a-zA-Z_]$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"aks":    {"azure kubernetes service", "aks", 1, 63, false, alphanumhu, "^[0-9a-zA-Z][0-9A-Za-z_.-]{0,61}[0-9a-zA-Z]$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"aksdns": {"aksdns prefix", "aksdns", 3, 45, false, alphanumh, "^[a-zA-Z][0-9A-Za-z-]{0,43}[0-9a-zA-Z]$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"aksnpl": {"aks node pool for Linux", "aksnpl", 2, 12, true, alphanum, "^[a-zA-Z][0-9a-z]{0,11}$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"aksnpw": {"aks node pool for Windows", "aksnpw", 2, 6, true, alphanum, "^[a-zA-Z][0-9a-z]{0,5}$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"apim":   {"api management", "apim", 1, 50, false, alphanum, "^[a-zA-Z][0-9A-Za-z]{0,49}$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"app":    {"web app", "app", 2, 60, false, alphanumh, "^[0-9A-Za-z][0-9A-Za-z-]{0,58}[0-9a-zA-Z]$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"appi":   {"application insights", "appi", 1, 260, false, invappi, "^[^%&\\?/. ][^%&\\?/]{0,258}[^%&\\?/. ]$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"ase":    {"app service environment", "ase", 2, 36, false, alphanumh, "^[0-9A-Za-z-]{2,36}$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"asr":    {"azure site recovery", "asr", 2, 50, false, alphanumh, "^[a-zA-Z][0-9A-Za-z-]{1,49}$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"dcr":    {"data collection rule", "dcr", 3, 44, false, alphanumhup, "^[a-zA-Z0-9][a-zA-Z0-9-]{1,42}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"evh":    {"event hub", "evh", 1, 50, false, alphanumh, "^[a-zA-Z][0-9A-Za-z-]{0,48}[0-9a-zA-Z]$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"gen":    {"generic", "gen", 1, 24, false, alphanum, "^[0-9a-zA-Z]{1,24}$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"kv":     {"keyvault", "kv", 3, 24, true, alphanumh, "^[a-zA-Z][0-9A-Za-z-]{0,22}[0-9a-zA-Z]$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"la":     {"loganalytics", "la", 4, 63, false, alphanumh, "^[0-9a-zA-Z][0-9A-Za-z-]{3,61}[0-9a-zA-Z]$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"las":    {"log analytics solution", "las", 4, 63, false, alphanumh, "^[0-9a-zA-Z][0-9A-Za-z-]{2,61}[0-9a-zA-Z]$", true, "parent", false, OfficialData{}, nil, false},
+	"laqp":   {"log analytics query pack", "laqp", 4, 63, false, alphanumh, "^[0-9a-zA-Z][0-9A-Za-z-]{2,61}[0-9a-zA-Z]$", true, "parent", false, OfficialData{}, nil, false},
+	"nic":    {"network interface card", "nic", 1, 80, false, alphanumhup, "^[0-9a-zA-Z][0-9A-Za-z_.-]{0,78}[0-9a-zA-Z_]$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"nsg":    {"network security group", "nsg", 1, 80, false, alphanumhup, "^[0-9a-zA-Z][0-9A-Za-z_.-]{0,78}[0-9a-zA-Z_]$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"pip":    {"public ip address", "pip", 1, 80, false, alphanumhup, "^[0-9a-zA-Z][0-9A-Za-z_.-]{0,78}[0-9a-zA-Z_]$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"plan":   {"app service plan", "plan", 1, 40, false, alphanumh, "^[0-9A-Za-z-]{1,40}$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"rg":     {"resource group", "rg", 1, 80, false, unicode, `^[-\w\._\(\)]{1,80}$`, true, "resourceGroup", false, OfficialData{}, nil, false},
+	"snet":   {"virtual network subnet", "snet", 1, 80, false, alphanumhup, "^[0-9a-zA-Z][0-9A-Za-z_.-]{0,78}[0-9a-zA-Z_]$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"sql":    {"azure sql db server", "sql", 1, 63, true, alphanumh, "^[0-9a-z][0-9a-z-]{0,61}[0-9a-z]$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"sqldb":  {"azure sql db", "sqldb", 1, 128, false, invsqldb, "^[^<>*%&:\\/?. ][^<>*%&:\\/?]{0,126}[^<>*%&:\\/?. ]$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"st":     {"storage account", "st", 3, 24, true, alphanum, "^[0-9a-z]{3,24}$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"vml":    {"virtual machine (linux)", "vml", 1, 64, false, alphanumh, "^[0-9a-zA-Z][0-9A-Za-z_-]{0,62}[0-9a-zA-Z_]$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"vmw":    {"virtual machine (windows)", "vmw", 1, 15, false, alphanumh, "^[0-9a-zA-Z][0-9A-Za-z_-]{0,13}[0-9a-zA-Z_]$", true, "resourceGroup", false, OfficialData{}, nil, false},
+	"vnet":   {"virtual network", "vnet", 2, 64, false, alphanumhup, "^[0-9a-zA-Z][0-9A-Za-z_.-]{0,62}[0-9a-zA-Z_]$", true, "resourceGroup", false, OfficialData{}, nil, false},
 }
 
 // ResourcesMapping enforcing new naming convention
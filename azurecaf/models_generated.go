@@ -6,500 +6,500 @@ package azurecaf
 
 // ResourceDefinitions are a map of difinitions for the resources supported
 var ResourceDefinitions = map[string]ResourceStructure{
-	"aks_node_pool_linux":                                              {"aks_node_pool_linux", "npl", 1, 12, false, "[^0-9a-z]", "^[a-z][0-9a-z]{0,11}$", false, "parent"},
-	"aks_node_pool_windows":                                            {"aks_node_pool_windows", "npw", 1, 6, false, "[^0-9a-z]", "^[a-z][0-9a-z]{0,5}$", false, "parent"},
-	"azurerm_aadb2c_directory":                                         {"azurerm_aadb2c_directory", "aadb2c", 1, 75, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,73}[a-zA-Z0-9]$", true, "global"},
-	"azurerm_analysis_services_server":                                 {"azurerm_analysis_services_server", "as", 3, 63, true, "[^0-9a-z]", "^[a-z][a-z0-9]{2,62}$", false, "resourceGroup"},
-	"azurerm_api_management":                                           {"azurerm_api_management", "apim", 1, 50, false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,48}[a-zA-Z0-9|]$", true, "global"},
-	"azurerm_api_management_api":                                       {"azurerm_api_management_api", "apimapi", 1, 80, false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,78}[a-zA-Z0-9|]$", true, "global"},
-	"azurerm_api_management_api_operation_tag":                         {"azurerm_api_management_api_operation_tag", "apimapiopt", 1, 80, false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,78}[a-zA-Z0-9|]$", true, "global"},
-	"azurerm_api_management_backend":                                   {"azurerm_api_management_backend", "apimbe", 1, 80, false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,78}[a-zA-Z0-9|]$", true, "global"},
-	"azurerm_api_management_certificate":                               {"azurerm_api_management_certificate", "apimcer", 1, 80, false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,78}[a-zA-Z0-9|]$", true, "global"},
-	"azurerm_api_management_gateway":                                   {"azurerm_api_management_gateway", "apimgw", 1, 80, false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,78}[a-zA-Z0-9|]$", true, "global"},
-	"azurerm_api_management_group":                                     {"azurerm_api_management_group", "apimgr", 1, 80, false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,78}[a-zA-Z0-9|]$", true, "global"},
-	"azurerm_api_management_logger":                                    {"azurerm_api_management_logger", "apimlg", 1, 80, false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,78}[a-zA-Z0-9|]$", true, "global"},
-	"azurerm_api_management_service":                                   {"azurerm_api_management_service", "apim", 1, 50, false, "[^0-9A-Za-z-]", "^[a-z][a-zA-Z0-9-]{0,48}[a-zA-Z0-9]$", true, "global"},
-	"azurerm_app_configuration":                                        {"azurerm_app_configuration", "appcg", 5, 50, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{5,50}$", true, "resourceGroup"},
-	"azurerm_app_service":                                              {"azurerm_app_service", "app", 2, 60, false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,58}[0-9a-zA-Z]$", true, "global"},
-	"azurerm_app_service_certificate":                                  {"azurerm_app_service_certificate", "appcert", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_app_service_environment":                                  {"azurerm_app_service_environment", "ase", 2, 36, false, "[^0-9A-Za-z-]", "^[0-9A-Za-z-]{2,36}$", true, "resourceGroup"},
-	"azurerm_app_service_plan":                                         {"azurerm_app_service_plan", "plan", 1, 40, false, "[^0-9A-Za-z-]", "^[0-9A-Za-z-]{1,40}$", true, "resourceGroup"},
-	"azurerm_app_service_slot":                                         {"azurerm_app_service_slot", "apps", 1, 59, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,57}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_application_gateway":                                      {"azurerm_application_gateway", "agw", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_application_insights":                                     {"azurerm_application_insights", "appi", 1, 260, false, "[^0-9A-Za-z_.-]", "^[^%&\\?/. ][^%&\\?/]{0,258}[^%&\\?/. ]$", true, "resourceGroup"},
-	"azurerm_application_insights_web_test":                            {"azurerm_application_insights_web_test", "appiwt", 1, 64, false, "[^0-9A-Za-z- ]", "^[a-zA-Z][a-zA-Z0-9- ]{0,62}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_application_security_group":                               {"azurerm_application_security_group", "asg", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_automation_account":                                       {"azurerm_automation_account", "aa", 6, 50, false, "[^0-9A-Za-z_-]", "^[a-zA-Z][a-zA-Z0-9-]{4,48}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_automation_certificate":                                   {"azurerm_automation_certificate", "aacert", 1, 128, false, `[^-\w\._\(\)]`, "^[^<>*%:.?\\+\\/]{0,127}[^<>*%:.?\\+\\/ ]$", true, "parent"},
-	"azurerm_automation_connection":                                    {"azurerm_automation_connection", "aacon", 1, 128, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,126}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_automation_credential":                                    {"azurerm_automation_credential", "aacred", 1, 128, false, `[^-\w\._\(\)]`, "^[^<>*%:.?\\+\\/]{0,127}[^<>*%:.?\\+\\/ ]$", true, "parent"},
-	"azurerm_automation_dsc_configuration":                             {"azurerm_automation_dsc_configuration", "aadsc", 1, 128, false, "[^A-Za-z0-9_]", "^[a-zA-Z][a-zA-Z0-9_]{0,127}$", false, "parent"},
-	"azurerm_automation_hybrid_runbook_worker_group":                   {"azurerm_automation_hybrid_runbook_worker_group", "aahwg", 1, 128, false, "[<>*%&:\\?.+/#]", "^([^<>*%&:\\?.+/#\\s]?[ ]?){0,127}[^<>*%&:\\?.+/#\\s]$", true, "parent"},
-	"azurerm_automation_job_schedule":                                  {"azurerm_automation_job_schedule", "aajs", 1, 128, false, `[^-\w\._\(\)]`, "^[^<>*%:.?\\+\\/]{0,127}[^<>*%:.?\\+\\/ ]$", true, "parent"},
-	"azurerm_automation_module":                                        {"azurerm_automation_module", "aamod", 1, 128, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,126}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_automation_runbook":                                       {"azurerm_automation_runbook", "aarun", 1, 63, false, "[^0-9A-Za-z_]", "^[a-zA-Z][a-zA-Z0-9-]{0,62}$", true, "parent"},
-	"azurerm_automation_schedule":                                      {"azurerm_automation_schedule", "aasched", 1, 128, false, `[^-\w\._\(\)]`, "^[^<>*%:.?\\+\\/]{0,127}[^<>*%:.?\\+\\/ ]$", true, "parent"},
-	"azurerm_automation_variable":                                      {"azurerm_automation_variable", "aavar", 1, 128, false, `[^-\w\._\(\)]`, "^[^<>*%:.?\\+\\/]{0,127}[^<>*%:.?\\+\\/ ]$", true, "parent"},
-	"azurerm_availability_set":                                         {"azurerm_availability_set", "avail", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_bastion_host":                                             {"azurerm_bastion_host", "bast", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_batch_account":                                            {"azurerm_batch_account", "ba", 3, 24, true, "[^0-9a-z]", "^[a-z0-9]{3,24}$", false, "region"},
-	"azurerm_batch_application":                                        {"azurerm_batch_application", "baapp", 1, 64, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,64}$", true, "parent"},
-	"azurerm_batch_certificate":                                        {"azurerm_batch_certificate", "bacert", 5, 45, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{5,45}$", true, "parent"},
-	"azurerm_batch_pool":                                               {"azurerm_batch_pool", "bapool", 3, 24, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9_-]{1,24}$", true, "parent"},
-	"azurerm_blueprint_assignment":                                     {"azurerm_blueprint_assignment", "bpa", 1, 90, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,88}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_bot_channel_Email":                                        {"azurerm_bot_channel_Email", "botmail", 2, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "parent"},
-	"azurerm_bot_channel_directline":                                   {"azurerm_bot_channel_directline", "botline", 2, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "parent"},
-	"azurerm_bot_channel_ms_teams":                                     {"azurerm_bot_channel_ms_teams", "botteams", 2, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "parent"},
-	"azurerm_bot_channel_slack":                                        {"azurerm_bot_channel_slack", "botslack", 2, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "parent"},
-	"azurerm_bot_channels_registration":                                {"azurerm_bot_channels_registration", "botchan", 2, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "parent"},
-	"azurerm_bot_connection":                                           {"azurerm_bot_connection", "botcon", 2, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "parent"},
-	"azurerm_bot_service_azure_bot":                                    {"azurerm_bot_service_azure_bot", "botaz", 2, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "global"},
-	"azurerm_bot_web_app":                                              {"azurerm_bot_web_app", "bot", 2, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "global"},
-	"azurerm_cdn_endpoint":                                             {"azurerm_cdn_endpoint", "cdn", 1, 50, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,48}[a-zA-Z0-9]$", true, "global"},
-	"azurerm_cdn_frontdoor_custom_domain":                              {"azurerm_cdn_frontdoor_custom_domain", "cfdcd", 1, 260, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,258}[a-zA-Z0-9])?$", true, "parent"},
-	"azurerm_cdn_frontdoor_endpoint":                                   {"azurerm_cdn_frontdoor_endpoint", "cfde", 1, 46, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,44}[a-zA-Z0-9])?$", true, "global"},
-	"azurerm_cdn_frontdoor_firewall_policy":                            {"azurerm_cdn_frontdoor_firewall_policy", "cfdfp", 1, 128, false, "[^0-9A-Za-z]", "^[a-zA-Z][0-9a-zA-Z]{0,127}$", false, "resourceGroup"},
-	"azurerm_cdn_frontdoor_origin":                                     {"azurerm_cdn_frontdoor_origin", "cfdo", 1, 90, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,88}[a-zA-Z0-9])?$", true, "parent"},
-	"azurerm_cdn_frontdoor_origin_group":                               {"azurerm_cdn_frontdoor_origin_group", "cfdog", 1, 90, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,88}[a-zA-Z0-9])?$", true, "parent"},
-	"azurerm_cdn_frontdoor_profile":                                    {"azurerm_cdn_frontdoor_profile", "cfdp", 1, 90, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,88}[a-zA-Z0-9])?$", true, "resourceGroup"},
-	"azurerm_cdn_frontdoor_route":                                      {"azurerm_cdn_frontdoor_route", "cfdroute", 1, 90, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,88}[a-zA-Z0-9])?$", true, "parent"},
-	"azurerm_cdn_frontdoor_rule":                                       {"azurerm_cdn_frontdoor_rule", "cfdr", 1, 60, false, "[^0-9A-Za-z]", "^[a-zA-Z][a-zA-Z0-9]{0,59}$", false, "parent"},
-	"azurerm_cdn_frontdoor_rule_set":                                   {"azurerm_cdn_frontdoor_rule_set", "cfdrs", 1, 60, false, "[^0-9A-Za-z]", "^[a-zA-Z][a-zA-Z0-9]{0,59}$", false, "parent"},
-	"azurerm_cdn_frontdoor_secret":                                     {"azurerm_cdn_frontdoor_secret", "cfds", 2, 260, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,258}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_cdn_frontdoor_security_policy":                            {"azurerm_cdn_frontdoor_security_policy", "cfdsp", 1, 260, false, "[^0-9A-Za-z_-]", "^[0-9A-Za-z_-]{1,260}$", true, "parent"},
-	"azurerm_cdn_profile":                                              {"azurerm_cdn_profile", "cdnprof", 1, 260, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,258}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_cognitive_account":                                        {"azurerm_cognitive_account", "cog", 2, 64, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,63}$", true, "resourceGroup"},
-	"azurerm_cognitive_deployment":                                     {"azurerm_cognitive_deployment", "cog", 2, 64, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,63}$", true, "resourceGroup"},
-	"azurerm_communication_service":                                    {"azurerm_communication_service", "acs", 1, 64, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,64}$", true, "parent"},
-	"azurerm_consumption_budget_resource_group":                        {"azurerm_consumption_budget_resource_group", "acbrg", 1, 63, false, "[^a-zA-Z0-9-_]", "^[a-zA-Z0-9-_]{1,63}$", true, "resourceGroup"},
-	"azurerm_consumption_budget_subscription":                          {"azurerm_consumption_budget_subscription", "acbs", 1, 63, false, "[^a-zA-Z0-9-_]", "^[a-zA-Z0-9-_]{1,63}$", true, "subscription"},
-	"azurerm_containerGroups":                                          {"azurerm_containerGroups", "cg", 1, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,61}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_container_app":                                            {"azurerm_container_app", "ca", 1, 32, true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{0,30}[a-z0-9]$", true, "resourceGroup"},
-	"azurerm_container_app_environment":                                {"azurerm_container_app_environment", "cae", 1, 60, false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,58}[0-9a-zA-Z]$", true, "resourceGroup"},
-	"azurerm_container_group":                                          {"azurerm_container_group", "aci", 1, 63, true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{0,61}[a-z0-9]$", true, "resourceGroup"},
-	"azurerm_container_registry":                                       {"azurerm_container_registry", "cr", 1, 63, true, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{1,63}$", false, "resourceGroup"},
-	"azurerm_container_registry_webhook":                               {"azurerm_container_registry_webhook", "crwh", 1, 50, false, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{1,50}$", false, "resourceGroup"},
-	"azurerm_cosmosdb_account":                                         {"azurerm_cosmosdb_account", "cosmos", 1, 44, true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{0,42}[a-z0-9]$", true, "resourceGroup"},
-	"azurerm_cosmosdb_cassandra_keyspace":                              {"azurerm_cosmosdb_cassandra_keyspace", "coscas", 1, 255, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,253}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_cosmosdb_gremlin_database":                                {"azurerm_cosmosdb_gremlin_database", "cosgrm", 1, 255, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,253}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_cosmosdb_gremlin_graph":                                   {"azurerm_cosmosdb_gremlin_graph", "cosgrmg", 1, 255, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,253}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_cosmosdb_mongo_collection":                                {"azurerm_cosmosdb_mongo_collection", "cosmonc", 1, 255, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,253}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_cosmosdb_mongo_database":                                  {"azurerm_cosmosdb_mongo_database", "cosmondb", 1, 255, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,253}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_cosmosdb_sql_container":                                   {"azurerm_cosmosdb_sql_container", "cosqlc", 1, 255, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,253}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_cosmosdb_sql_database":                                    {"azurerm_cosmosdb_sql_database", "cosqldb", 1, 255, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,253}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_cosmosdb_sql_stored_procedure":                            {"azurerm_cosmosdb_sql_stored_procedure", "cosqlsp", 1, 255, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,253}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_cosmosdb_table":                                           {"azurerm_cosmosdb_table", "costbl", 1, 255, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,253}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_custom_provider":                                          {"azurerm_custom_provider", "prov", 3, 64, false, "[&%.?\\/]", "^[^&%?\\/]{2,63}[^&%.?\\/ ]$", true, "resourceGroup"},
-	"azurerm_dashboard":                                                {"azurerm_dashboard", "dsb", 3, 160, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{3,160}$", true, "parent"},
-	"azurerm_data_factory":                                             {"azurerm_data_factory", "adf", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "global"},
-	"azurerm_data_factory_dataset_azure_blob":                          {"azurerm_data_factory_dataset_azure_blob", "adfblob", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_data_factory_dataset_cosmosdb_sqlapi":                     {"azurerm_data_factory_dataset_cosmosdb_sqlapi", "adfsqlapi", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_data_factory_dataset_delimited_text":                      {"azurerm_data_factory_dataset_delimited_text", "adfdtext", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_data_factory_dataset_http":                                {"azurerm_data_factory_dataset_http", "adfhttp", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_data_factory_dataset_json":                                {"azurerm_data_factory_dataset_json", "adfjson", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_data_factory_dataset_mysql":                               {"azurerm_data_factory_dataset_mysql", "adfmysql", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_data_factory_dataset_postgresql":                          {"azurerm_data_factory_dataset_postgresql", "adfpsql", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_data_factory_dataset_sql_server_table":                    {"azurerm_data_factory_dataset_sql_server_table", "adfmssql", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_data_factory_integration_runtime_managed":                 {"azurerm_data_factory_integration_runtime_managed", "adfir", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_data_factory_integration_runtime_self_hosted":             {"azurerm_data_factory_integration_runtime_self_hosted", "adfirsh", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_data_factory_linked_service_azure_blob_storage":           {"azurerm_data_factory_linked_service_azure_blob_storage", "adflsabs", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent"},
-	"azurerm_data_factory_linked_service_azure_databricks":             {"azurerm_data_factory_linked_service_azure_databricks", "adflsadb", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent"},
-	"azurerm_data_factory_linked_service_azure_function":               {"azurerm_data_factory_linked_service_azure_function", "adflsaf", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent"},
-	"azurerm_data_factory_linked_service_azure_sql_database":           {"azurerm_data_factory_linked_service_azure_sql_database", "adflsasdb", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent"},
-	"azurerm_data_factory_linked_service_cosmosdb":                     {"azurerm_data_factory_linked_service_cosmosdb", "adflsacdb", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent"},
-	"azurerm_data_factory_linked_service_data_lake_storage_gen2":       {"azurerm_data_factory_linked_service_data_lake_storage_gen2", "adfsvst", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent"},
-	"azurerm_data_factory_linked_service_key_vault":                    {"azurerm_data_factory_linked_service_key_vault", "adfsvkv", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent"},
-	"azurerm_data_factory_linked_service_mysql":                        {"azurerm_data_factory_linked_service_mysql", "adfsvmysql", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent"},
-	"azurerm_data_factory_linked_service_postgresql":                   {"azurerm_data_factory_linked_service_postgresql", "adfsvpsql", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent"},
-	"azurerm_data_factory_linked_service_sftp":                         {"azurerm_data_factory_linked_service_sftp", "adflsaftp", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent"},
-	"azurerm_data_factory_linked_service_sql_server":                   {"azurerm_data_factory_linked_service_sql_server", "adfsvmssql", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent"},
-	"azurerm_data_factory_linked_service_web":                          {"azurerm_data_factory_linked_service_web", "adfsvweb", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent"},
-	"azurerm_data_factory_pipeline":                                    {"azurerm_data_factory_pipeline", "adfpl", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_data_factory_trigger_schedule":                            {"azurerm_data_factory_trigger_schedule", "adftg", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent"},
-	"azurerm_data_lake_analytics_account":                              {"azurerm_data_lake_analytics_account", "dla", 3, 24, false, "[^0-9a-z]", "^[a-z0-9]{3,24}$", false, "global"},
-	"azurerm_data_lake_analytics_firewall_rule":                        {"azurerm_data_lake_analytics_firewall_rule", "dlfw", 3, 50, false, "[^0-9a-z_-]", "^[a-z0-9-_]{3,50}$", true, "parent"},
-	"azurerm_data_lake_store":                                          {"azurerm_data_lake_store", "dls", 3, 24, false, "[^0-9a-z]", "^[a-z0-9]{3,24}$", false, "parent"},
-	"azurerm_data_lake_store_firewall_rule":                            {"azurerm_data_lake_store_firewall_rule", "dlsfw", 3, 50, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,50}$", true, "parent"},
-	"azurerm_data_protection_backup_policy_blob_storage":               {"azurerm_data_protection_backup_policy_blob_storage", "dpbpb", 3, 150, false, `[^a-zA-Z0-9-]`, "^[a-zA-Z][a-zA-Z0-9\\-]{1,148}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_data_protection_backup_policy_disk":                       {"azurerm_data_protection_backup_policy_disk", "dpbpd", 3, 150, false, `[^a-zA-Z0-9-]`, "^[a-zA-Z][a-zA-Z0-9\\-]{1,148}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_data_protection_backup_policy_postgresql":                 {"azurerm_data_protection_backup_policy_postgresql", "dpbpp", 3, 150, false, `[^a-zA-Z0-9-]`, "^[a-zA-Z][a-zA-Z0-9\\-]{1,148}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_data_protection_backup_policy_postgresql_flexible_server": {"azurerm_data_protection_backup_policy_postgresql_flexible_server", "dpbppf", 3, 150, false, `[^a-zA-Z0-9-]`, "^[a-zA-Z][a-zA-Z0-9\\-]{1,148}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_data_protection_backup_vault":                             {"azurerm_data_protection_backup_vault", "dpbv", 2, 50, false, `[^a-zA-Z0-9-]`, "^[a-zA-Z][a-zA-Z0-9-]{1,49}$", true, "resourceGroup"},
-	"azurerm_data_share":                                               {"azurerm_data_share", "dshr", 2, 90, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,89}$", true, "parent"},
-	"azurerm_data_share_account":                                       {"azurerm_data_share_account", "dshra", 2, 90, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,89}$", true, "resourceGroup"},
-	"azurerm_data_share_dataset_blob_storage":                          {"azurerm_data_share_dataset_blob_storage", "dshrdsb", 2, 90, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,89}$", true, "parent"},
-	"azurerm_data_share_dataset_data_lake_gen1":                        {"azurerm_data_share_dataset_data_lake_gen1", "dshrdsg1", 2, 90, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,89}$", true, "parent"},
-	"azurerm_data_share_dataset_data_lake_gen2":                        {"azurerm_data_share_dataset_data_lake_gen2", "dshrdsg2", 2, 90, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,89}$", true, "parent"},
-	"azurerm_data_share_dataset_kusto_cluster":                         {"azurerm_data_share_dataset_kusto_cluster", "dshrdskc", 2, 90, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,89}$", true, "parent"},
-	"azurerm_data_share_dataset_kusto_database":                        {"azurerm_data_share_dataset_kusto_database", "dshrdskd", 2, 90, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,89}$", true, "parent"},
-	"azurerm_database_migration_project":                               {"azurerm_database_migration_project", "migr", 2, 57, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,56}$", true, "parent"},
-	"azurerm_database_migration_service":                               {"azurerm_database_migration_service", "dms", 2, 62, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,61}$", true, "resourceGroup"},
-	"azurerm_databricks_workspace":                                     {"azurerm_databricks_workspace", "dbw", 3, 64, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,64}$", true, "resourceGroup"},
-	"azurerm_dedicated_hardware_security_module":                       {"azurerm_dedicated_hardware_security_module", "hsm", 3, 24, false, "[^0-9A-Za-z-]", "^[a-zA-Z][a-zA-Z0-9-]{1,22}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_dedicated_host":                                           {"azurerm_dedicated_host", "dh", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_dedicated_host_group":                                     {"azurerm_dedicated_host_group", "dhg", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_dev_center":                                               {"azurerm_dev_center", "dc", 3, 26, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,24}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_dev_center_catalog":                                       {"azurerm_dev_center_catalog", "dcc", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_dev_center_dev_box_definition":                            {"azurerm_dev_center_dev_box_definition", "dcdb", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_dev_center_environment_type":                              {"azurerm_dev_center_environment_type", "dcet", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_dev_center_gallery":                                       {"azurerm_dev_center_gallery", "dcg", 1, 80, false, "[^0-9A-Za-z_.]", "^[a-zA-Z0-9]([a-zA-Z0-9_.]{0,78}[a-zA-Z0-9])?$", false, "parent"},
-	"azurerm_dev_center_network_connection":                            {"azurerm_dev_center_network_connection", "dcnc", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_dev_center_project":                                       {"azurerm_dev_center_project", "dcp", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_dev_center_project_environment_type":                      {"azurerm_dev_center_project_environment_type", "dcpet", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_dev_test_lab":                                             {"azurerm_dev_test_lab", "lab", 1, 50, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,50}$", true, "resourceGroup"},
-	"azurerm_dev_test_linux_virtual_machine":                           {"azurerm_dev_test_linux_virtual_machine", "labvm", 1, 64, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{1,64}$", true, "parent"},
-	"azurerm_dev_test_schedule":                                        {"azurerm_dev_test_schedule", "dtls", 1, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,62}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_dev_test_virtual_network":                                 {"azurerm_dev_test_virtual_network", "dtlvn", 1, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,62}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_dev_test_windows_virtual_machine":                         {"azurerm_dev_test_windows_virtual_machine", "labvm", 1, 15, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{1,15}$", true, "parent"},
-	"azurerm_devspace_controller":                                      {"azurerm_devspace_controller", "dsc", 1, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,61}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_digital_twins_endpoint_eventgrid":                         {"azurerm_digital_twins_endpoint_eventgrid", "adteg", 3, 50, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,50}$", true, "parent"},
-	"azurerm_digital_twins_endpoint_eventhub":                          {"azurerm_digital_twins_endpoint_eventhub", "adteh", 3, 50, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,50}$", true, "parent"},
-	"azurerm_digital_twins_endpoint_servicebus":                        {"azurerm_digital_twins_endpoint_servicebus", "adtsb", 3, 50, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,50}$", true, "parent"},
-	"azurerm_digital_twins_instance":                                   {"azurerm_digital_twins_instance", "adt", 4, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,63}$", true, "subscription"},
-	"azurerm_disk_encryption_set":                                      {"azurerm_disk_encryption_set", "des", 1, 80, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,80}$", true, "resourceGroup"},
-	"azurerm_dns_a_record":                                             {"azurerm_dns_a_record", "dnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_dns_aaaa_record":                                          {"azurerm_dns_aaaa_record", "dnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_dns_caa_record":                                           {"azurerm_dns_caa_record", "dnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_dns_cname_record":                                         {"azurerm_dns_cname_record", "dnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_dns_mx_record":                                            {"azurerm_dns_mx_record", "dnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_dns_ns_record":                                            {"azurerm_dns_ns_record", "dnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_dns_ptr_record":                                           {"azurerm_dns_ptr_record", "dnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_dns_txt_record":                                           {"azurerm_dns_txt_record", "dnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_dns_zone":                                                 {"azurerm_dns_zone", "dns", 1, 63, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,61}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_email_communication_service":                              {"azurerm_email_communication_service", "acsmail", 1, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,61}[a-zA-Z0-9]$", true, "global"},
-	"azurerm_eventgrid_domain":                                         {"azurerm_eventgrid_domain", "egd", 3, 50, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{3,50}$", true, "resourceGroup"},
-	"azurerm_eventgrid_domain_topic":                                   {"azurerm_eventgrid_domain_topic", "egdt", 3, 50, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{3,50}$", true, "parent"},
-	"azurerm_eventgrid_event_subscription":                             {"azurerm_eventgrid_event_subscription", "egs", 3, 64, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{3,64}$", true, "resourceGroup"},
-	"azurerm_eventgrid_topic":                                          {"azurerm_eventgrid_topic", "egt", 3, 50, false, `[^0-9A-Za-z-]`, "^[a-zA-Z0-9-]{3,50}$", true, "resourceGroup"},
-	"azurerm_eventhub":                                                 {"azurerm_eventhub", "evh", 1, 50, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_eventhub_authorization_rule":                              {"azurerm_eventhub_authorization_rule", "ehar", 1, 50, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_eventhub_cluster":                                         {"azurerm_eventhub_cluster", "ehc", 1, 50, false, "[^0-9A-Za-z-]", "^[a-zA-Z][a-zA-Z0-9-]{0,48}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_eventhub_consumer_group":                                  {"azurerm_eventhub_consumer_group", "ehcg", 1, 50, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_eventhub_namespace":                                       {"azurerm_eventhub_namespace", "ehn", 1, 50, false, "[^0-9A-Za-z-]", "^[a-zA-Z][a-zA-Z0-9-]{0,48}[a-zA-Z0-9]$", true, "global"},
-	"azurerm_eventhub_namespace_authorization_rule":                    {"azurerm_eventhub_namespace_authorization_rule", "ehnar", 1, 50, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_eventhub_namespace_disaster_recovery_config":              {"azurerm_eventhub_namespace_disaster_recovery_config", "ehdr", 1, 50, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_express_route_circuit":                                    {"azurerm_express_route_circuit", "erc", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_express_route_circuit_authorization":                      {"azurerm_express_route_circuit_authorization", "erca", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_express_route_gateway":                                    {"azurerm_express_route_gateway", "ergw", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_federated_identity_credential":                            {"azurerm_federated_identity_credential", "fedcred", 3, 120, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9][a-zA-Z0-9_-]{2,119}$", true, "parent"},
-	"azurerm_firewall":                                                 {"azurerm_firewall", "fw", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_firewall_application_rule_collection":                     {"azurerm_firewall_application_rule_collection", "fwapp", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_firewall_ip_configuration":                                {"azurerm_firewall_ip_configuration", "fwipconf", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_firewall_nat_rule_collection":                             {"azurerm_firewall_nat_rule_collection", "fwnatrc", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_firewall_network_rule_collection":                         {"azurerm_firewall_network_rule_collection", "fwnetrc", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_firewall_policy":                                          {"azurerm_firewall_policy", "afwp", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_firewall_policy_rule_collection_group":                    {"azurerm_firewall_policy_rule_collection_group", "fwprcg", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_frontdoor":                                                {"azurerm_frontdoor", "fd", 5, 64, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{3,62}[a-zA-Z0-9]$", true, "global"},
-	"azurerm_frontdoor_firewall_policy":                                {"azurerm_frontdoor_firewall_policy", "fdfw", 1, 80, false, "[^0-9A-Za-z]", "^[a-zA-Z0-9][a-zA-Z0-9]{0,78}[a-zA-Z0-9]$", false, "global"},
-	"azurerm_function_app":                                             {"azurerm_function_app", "fa", 2, 60, false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,58}[0-9a-zA-Z]$", true, "global"},
-	"azurerm_function_app_slot":                                        {"azurerm_function_app_slot", "fas", 2, 59, false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,57}[0-9a-zA-Z]$", true, "global"},
-	"azurerm_hdinsight_hadoop_cluster":                                 {"azurerm_hdinsight_hadoop_cluster", "hadoop", 3, 59, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global"},
-	"azurerm_hdinsight_hbase_cluster":                                  {"azurerm_hdinsight_hbase_cluster", "hbase", 3, 59, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global"},
-	"azurerm_hdinsight_interactive_query_cluster":                      {"azurerm_hdinsight_interactive_query_cluster", "iqr", 3, 59, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global"},
-	"azurerm_hdinsight_kafka_cluster":                                  {"azurerm_hdinsight_kafka_cluster", "kafka", 3, 59, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global"},
-	"azurerm_hdinsight_ml_services_cluster":                            {"azurerm_hdinsight_ml_services_cluster", "mls", 3, 59, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global"},
-	"azurerm_hdinsight_rserver_cluster":                                {"azurerm_hdinsight_rserver_cluster", "rser", 3, 59, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global"},
-	"azurerm_hdinsight_spark_cluster":                                  {"azurerm_hdinsight_spark_cluster", "spark", 3, 59, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global"},
-	"azurerm_hdinsight_storm_cluster":                                  {"azurerm_hdinsight_storm_cluster", "storm", 3, 59, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global"},
-	"azurerm_healthcare_dicom_service":                                 {"azurerm_healthcare_dicom_service", "dicom", 3, 24, true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,22}[a-z0-9]$", true, "parent"},
-	"azurerm_healthcare_fhir_service":                                  {"azurerm_healthcare_fhir_service", "fhir", 3, 24, true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,22}[a-z0-9]$", true, "parent"},
-	"azurerm_healthcare_medtech_service":                               {"azurerm_healthcare_medtech_service", "medtech", 3, 24, true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,22}[a-z0-9]$", true, "parent"},
-	"azurerm_healthcare_service":                                       {"azurerm_healthcare_service", "hcasvc", 3, 24, true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,22}[a-z0-9]$", true, "global"},
-	"azurerm_healthcare_workspace":                                     {"azurerm_healthcare_workspace", "hcw", 3, 24, true, "[^0-9a-z]", "^[a-z0-9]{3,24}$", false, "global"},
-	"azurerm_hpc_cache":                                                {"azurerm_hpc_cache", "hpcc", 2, 31, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,29}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_hpc_cache_blob_target":                                    {"azurerm_hpc_cache_blob_target", "hpcbt", 2, 31, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,29}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_hpc_cache_nfs_target":                                     {"azurerm_hpc_cache_nfs_target", "hpcnt", 2, 31, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,29}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_image":                                                    {"azurerm_image", "img", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_integration_service_environment":                          {"azurerm_integration_service_environment", "lappise", 1, 80, false, "[^0-9A-Za-z\\-\\_\\.]", "^[0-9A-Za-z\\-\\_\\.]{1,80}$", true, "resourceGroup"},
-	"azurerm_iot_security_device_group":                                {"azurerm_iot_security_device_group", "iotdg", 1, 32, false, "[^0-9A-Za-z-._]", "^[a-zA-Z0-9-._]{1,32}$", true, "parent"},
-	"azurerm_iot_security_solution":                                    {"azurerm_iot_security_solution", "iotss", 1, 260, false, "[^0-9A-Za-z-_]", "^[a-zA-Z0-9-_]{1,260}$", true, "resourceGroup"},
-	"azurerm_iot_time_series_insights_reference_data_set":              {"azurerm_iot_time_series_insights_reference_data_set", "tsirds", 3, 63, false, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{3,63}$", false, "parent"},
-	"azurerm_iot_time_series_insights_standard_environment":            {"azurerm_iot_time_series_insights_standard_environment", "tsise", 1, 90, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,88}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_iotcentral_application":                                   {"azurerm_iotcentral_application", "iotapp", 2, 63, true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{0,61}[a-z0-9]$", true, "global"},
-	"azurerm_iothub":                                                   {"azurerm_iothub", "iot", 3, 50, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,48}[a-z0-9]$", true, "global"},
-	"azurerm_iothub_certificate":                                       {"azurerm_iothub_certificate", "iotcert", 1, 64, false, "[^0-9A-Za-z-._]", "^[a-zA-Z0-9-._]{1,64}$", true, "parent"},
-	"azurerm_iothub_consumer_group":                                    {"azurerm_iothub_consumer_group", "iotcg", 1, 50, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9-._]{1,50}$", true, "parent"},
-	"azurerm_iothub_dps":                                               {"azurerm_iothub_dps", "dps", 3, 64, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{1,63}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_iothub_dps_certificate":                                   {"azurerm_iothub_dps_certificate", "dpscert", 1, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9-._]{1,64}$", true, "parent"},
-	"azurerm_iothub_dps_shared_access_policy":                          {"azurerm_iothub_dps_shared_access_policy", "dpssap", 1, 64, false, "[^0-9A-Za-z-._]", "^[a-zA-Z0-9-._]{1,64}$", true, "parent"},
-	"azurerm_iothub_endpoint_eventhub":                                 {"azurerm_iothub_endpoint_eventhub", "iothepeh", 1, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,62}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_iothub_endpoint_servicebus_queue":                         {"azurerm_iothub_endpoint_servicebus_queue", "iothepsbq", 1, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,62}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_iothub_endpoint_servicebus_topic":                         {"azurerm_iothub_endpoint_servicebus_topic", "iothepsbt", 1, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,62}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_iothub_endpoint_storage_container":                        {"azurerm_iothub_endpoint_storage_container", "iothepsc", 1, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,62}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_iothub_route":                                             {"azurerm_iothub_route", "iothr", 1, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,62}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_iothub_shared_access_policy":                              {"azurerm_iothub_shared_access_policy", "iotsap", 1, 64, false, "[^0-9A-Za-z-._]", "^[a-zA-Z0-9-._]{1,64}$", true, "parent"},
-	"azurerm_ip_group":                                                 {"azurerm_ip_group", "ipgr", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_key_vault":                                                {"azurerm_key_vault", "kv", 3, 24, false, "[^0-9A-Za-z-]", "^[a-zA-Z][a-zA-Z0-9-]{1,22}[a-zA-Z0-9]$", true, "global"},
-	"azurerm_key_vault_certificate":                                    {"azurerm_key_vault_certificate", "kvc", 1, 127, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{1,127}$", true, "parent"},
-	"azurerm_key_vault_key":                                            {"azurerm_key_vault_key", "kvk", 1, 127, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{1,127}$", true, "parent"},
-	"azurerm_key_vault_secret":                                         {"azurerm_key_vault_secret", "kvs", 1, 127, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9-]{1,127}$", true, "parent"},
-	"azurerm_kubernetes_cluster":                                       {"azurerm_kubernetes_cluster", "aks", 1, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9][a-zA-Z0-9-_]{0,61}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_kubernetes_fleet_manager":                                 {"azurerm_kubernetes_fleet_manager", "fleet", 1, 63, true, "[^0-9a-z-]", "^[0-9a-z]([0-9a-z-]{0,61}[0-9a-z])?$", true, "resourceGroup"},
-	"azurerm_kusto_attached_database_configuration":                    {"azurerm_kusto_attached_database_configuration", "kadc", 1, 260, false, "[^0-9A-Za-z- .]", "^[a-zA-Z0-9- .]{1,260}$", true, "parent"},
-	"azurerm_kusto_cluster":                                            {"azurerm_kusto_cluster", "kc", 4, 22, false, "[^0-9A-Za-z]", "^[a-z][a-z0-9]{3,21}$", false, "global"},
-	"azurerm_kusto_database":                                           {"azurerm_kusto_database", "kdb", 1, 260, false, "[^0-9A-Za-z- .]", "^[a-zA-Z0-9- .]{1,260}$", true, "parent"},
-	"azurerm_kusto_eventhub_data_connection":                           {"azurerm_kusto_eventhub_data_connection", "kehc", 1, 40, false, "[^0-9A-Za-z- .]", "^[a-zA-Z0-9- .]{1,40}$", true, "parent"},
-	"azurerm_lb":                                                       {"azurerm_lb", "lb", 1, 80, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_lb_backend_address_pool":                                  {"azurerm_lb_backend_address_pool", "adt", 4, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,63}$", true, "subscription"},
-	"azurerm_lb_backend_pool":                                          {"azurerm_lb_backend_pool", "adt", 4, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,63}$", true, "subscription"},
-	"azurerm_lb_nat_pool":                                              {"azurerm_lb_nat_pool", "adt", 4, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,63}$", true, "subscription"},
-	"azurerm_lb_nat_rule":                                              {"azurerm_lb_nat_rule", "lbnatrl", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_lb_outbound_rule":                                         {"azurerm_lb_outbound_rule", "adt", 4, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,63}$", true, "subscription"},
-	"azurerm_lb_probe":                                                 {"azurerm_lb_probe", "adt", 4, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,63}$", true, "subscription"},
-	"azurerm_lb_rule":                                                  {"azurerm_lb_rule", "adt", 4, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,63}$", true, "subscription"},
-	"azurerm_lighthouse_definition":                                    {"azurerm_lighthouse_definition", "lhd", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_linux_function_app":                                       {"azurerm_linux_function_app", "fa", 2, 60, false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,58}[0-9a-zA-Z]$", true, "global"},
-	"azurerm_linux_function_app_slot":                                  {"azurerm_linux_function_app_slot", "fas", 2, 59, false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,57}[0-9a-zA-Z]$", true, "global"},
-	"azurerm_linux_virtual_machine":                                    {"azurerm_linux_virtual_machine", "vm", 1, 64, false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,62}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup"},
-	"azurerm_linux_virtual_machine_scale_set":                          {"azurerm_linux_virtual_machine_scale_set", "vmss", 1, 64, false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,62}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup"},
-	"azurerm_linux_web_app":                                            {"azurerm_linux_web_app", "lwapp", 2, 60, false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,58}[0-9a-zA-Z]$", true, "global"},
-	"azurerm_load_test":                                                {"azurerm_load_test", "load", 1, 64, false, "[^0-9A-Za-z-_]", "^[a-zA-Z][a-zA-Z0-9-_]{0,62}[a-zA-Z0-9|]$", true, "global"},
-	"azurerm_local_network_gateway":                                    {"azurerm_local_network_gateway", "lgw", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_log_analytics_cluster":                                    {"azurerm_log_analytics_cluster", "logc", 4, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{2,61}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_log_analytics_data_export_rule":                           {"azurerm_log_analytics_data_export_rule", "laer", 4, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{2,61}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_log_analytics_query_pack":                                 {"azurerm_log_analytics_query_pack", "laqp", 4, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{2,61}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_log_analytics_saved_search":                               {"azurerm_log_analytics_saved_search", "lass", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_log_analytics_solution":                                   {"azurerm_log_analytics_solution", "las", 4, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{2,61}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_log_analytics_storage_insights":                           {"azurerm_log_analytics_storage_insights", "lasi", 4, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{2,61}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_log_analytics_workspace":                                  {"azurerm_log_analytics_workspace", "log", 4, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{2,61}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_logic_app_action_custom":                                  {"azurerm_logic_app_action_custom", "lappac", 1, 80, false, "[^0-9A-Za-z\\(\\-\\)\\_\\.]", "^[0-9A-Za-z\\(\\-\\)\\_\\.]{1,80}$", true, "resourceGroup"},
-	"azurerm_logic_app_action_http":                                    {"azurerm_logic_app_action_http", "lappah", 1, 80, false, "[^0-9A-Za-z\\(\\-\\)\\_\\.]", "^[0-9A-Za-z\\(\\-\\)\\_\\.]{1,80}$", true, "resourceGroup"},
-	"azurerm_logic_app_integration_account":                            {"azurerm_logic_app_integration_account", "lappia", 1, 80, false, "[^0-9A-Za-z\\(\\-\\)\\_\\.]", "^[0-9A-Za-z\\(\\-\\)\\_\\.]{1,80}$", true, "resourceGroup"},
-	"azurerm_logic_app_trigger_custom":                                 {"azurerm_logic_app_trigger_custom", "lapptc", 1, 80, false, "[^0-9A-Za-z\\(\\-\\)\\_\\.]", "^[0-9A-Za-z\\(\\-\\)\\_\\.]{1,80}$", true, "resourceGroup"},
-	"azurerm_logic_app_trigger_http_request":                           {"azurerm_logic_app_trigger_http_request", "lappth", 1, 80, false, "[^0-9A-Za-z\\(\\-\\)\\_\\.]", "^[0-9A-Za-z\\(\\-\\)\\_\\.]{1,80}$", true, "resourceGroup"},
-	"azurerm_logic_app_trigger_recurrence":                             {"azurerm_logic_app_trigger_recurrence", "lapptc", 1, 80, false, "[^0-9A-Za-z\\(\\-\\)\\_\\.]", "^[0-9A-Za-z\\(\\-\\)\\_\\.]{1,80}$", true, "resourceGroup"},
-	"azurerm_logic_app_workflow":                                       {"azurerm_logic_app_workflow", "lapp", 1, 80, false, "[^0-9A-Za-z\\(\\-\\)\\_\\.]", "^[0-9A-Za-z\\(\\-\\)\\_\\.]{1,80}$", true, "resourceGroup"},
-	"azurerm_machine_learning_compute_instance":                        {"azurerm_machine_learning_compute_instance", "amlci", 1, 16, false, "[^0-9a-z-]", "^[a-zA-Z0-9][a-z0-9-]{0,14}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_machine_learning_workspace":                               {"azurerm_machine_learning_workspace", "mlw", 1, 260, false, "[<>*%:.?\\+\\/]", "^[^<>*%:.?\\+\\/]{0,259}[^<>*%:.?\\+\\/ ]$", true, "resourceGroup"},
-	"azurerm_maintenance_configuration":                                {"azurerm_maintenance_configuration", "mcf", 1, 60, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,58}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_managed_application":                                      {"azurerm_managed_application", "manapp", 1, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,62}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_managed_application_definition":                           {"azurerm_managed_application_definition", "manappd", 3, 64, false, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{3,64}$", false, "resourceGroup"},
-	"azurerm_managed_disk":                                             {"azurerm_managed_disk", "dsk", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_managed_redis":                                            {"azurerm_managed_redis", "amr", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_management_group":                                         {"azurerm_management_group", "mg", 1, 90, false, "[^0-9A-Za-z-_.()]", "^[a-zA-Z0-9-_.()]{1,90}$", true, "parent"},
-	"azurerm_management_lock":                                          {"azurerm_management_lock", "mgl", 1, 260, false, "[^0-9A-Za-z-_.]", "^[a-zA-Z0-9-_.]{1,260}$", true, "parent"},
-	"azurerm_maps_account":                                             {"azurerm_maps_account", "map", 1, 98, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,97}$", true, "resourceGroup"},
-	"azurerm_mariadb_database":                                         {"azurerm_mariadb_database", "mariadb", 1, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,63}$", true, "parent"},
-	"azurerm_mariadb_firewall_rule":                                    {"azurerm_mariadb_firewall_rule", "mariafw", 1, 128, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent"},
-	"azurerm_mariadb_server":                                           {"azurerm_mariadb_server", "maria", 3, 63, false, "[^0-9A-Za-z-]", "^[a-z0-9][a-zA-Z0-9-]{1,61}[a-z0-9]$", true, "global"},
-	"azurerm_mariadb_virtual_network_rule":                             {"azurerm_mariadb_virtual_network_rule", "mariavn", 1, 128, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent"},
-	"azurerm_media_services_account":                                   {"azurerm_media_services_account", "ams", 3, 24, true, "[^0-9a-z]", "^[a-z0-9]{3,24}$", false, "resourceGroup"},
-	"azurerm_monitor_action_group":                                     {"azurerm_monitor_action_group", "amag", 1, 260, false, `[^~!@$^*()\[\]\{\}_\-="';,0-9A-Za-z _.-]`, "^[^|:<>+#%&\\?/]{0,259}[^|:<>+#%&\\?/. ]$", true, "resourceGroup"},
-	"azurerm_monitor_action_rule_action_group":                         {"azurerm_monitor_action_rule_action_group", "marag", 1, 260, false, "[^0-9A-Za-z-_.]", "^[a-zA-Z0-9-_.]{1,260}$", true, "resourceGroup"},
-	"azurerm_monitor_action_rule_suppression":                          {"azurerm_monitor_action_rule_suppression", "mars", 1, 260, false, "[^0-9A-Za-z-_.]", "^[a-zA-Z0-9-_.]{1,260}$", true, "resourceGroup"},
-	"azurerm_monitor_activity_log_alert":                               {"azurerm_monitor_activity_log_alert", "adfmysql", 1, 260, false, "[^0-9A-Za-z<>*%:&?#\\+\\/]", "^[^<>*%:&?#\\+\\/]{0,259}[^<>*%:&.?#\\+\\/]$", true, "parent"},
-	"azurerm_monitor_autoscale_setting":                                {"azurerm_monitor_autoscale_setting", "amas", 2, 64, false, "[^0-9A-Za-z _.-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,62}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_monitor_data_collection_endpoint":                         {"azurerm_monitor_data_collection_endpoint", "dce", 3, 44, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,42}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_monitor_data_collection_rule":                             {"azurerm_monitor_data_collection_rule", "dcr", 3, 44, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,42}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_monitor_diagnostic_setting":                               {"azurerm_monitor_diagnostic_setting", "amds", 1, 260, false, "[^0-9A-Za-z _.-]", "^[a-zA-Z0-9 ][a-zA-Z0-9-._ ]{0,258}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_monitor_log_profile":                                      {"azurerm_monitor_log_profile", "mlp", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_monitor_metric_alert":                                     {"azurerm_monitor_metric_alert", "ma", 1, 251, false, "[<>*%&:\\?+/#@{}]", "^[^<>*%&:\\?+/#@{}]{0,250}[^<>*%&:\\?+/#@{}. ]$", true, "resourceGroup"},
-	"azurerm_monitor_private_link_scope":                               {"azurerm_monitor_private_link_scope", "ampls", 1, 255, false, "[^0-9A-Za-z-._()]", "^[0-9A-Za-z-._()]{0,254}[0-9A-Za-z-_()]$", true, "resourceGroup"},
-	"azurerm_monitor_scheduled_query_rules_alert":                      {"azurerm_monitor_scheduled_query_rules_alert", "schqra", 1, 260, false, "[<>*%&:\\?/#{}]", "^[^<>*%&:\\?/#{}]{0,259}[^<>*%&:\\?/#{}. ]$", true, "resourceGroup"},
-	"azurerm_monitor_scheduled_query_rules_log":                        {"azurerm_monitor_scheduled_query_rules_log", "msqrl", 1, 260, false, "[^0-9A-Za-z-_.]", "^[a-zA-Z0-9-_.]{1,260}$", true, "resourceGroup"},
-	"azurerm_monitor_smart_detector_alert_rule":                        {"azurerm_monitor_smart_detector_alert_rule", "msdar", 1, 260, false, "[^0-9A-Za-z-_.]", "^[a-zA-Z0-9-_.]{1,260}$", true, "resourceGroup"},
-	"azurerm_monitor_workspace":                                        {"azurerm_monitor_workspace", "amw", 4, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{2,61}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_mssql_database":                                           {"azurerm_mssql_database", "sqldb", 1, 128, false, "[<>*%:.?\\+\\/ ]", "^[^<>*%:.?\\+\\/]{1,127}[^<>*%:.?\\+\\/ ]$", true, "parent"},
-	"azurerm_mssql_elasticpool":                                        {"azurerm_mssql_elasticpool", "sqlep", 1, 128, false, "[<>*%:.?\\+\\/]", "^[^<>*%:.?\\+\\/]{1,127}[^<>*%:.?\\+\\/ ]$", true, "parent"},
-	"azurerm_mssql_mi":                                                 {"azurerm_mssql_mi", "sqlmi", 1, 63, true, "[^0-9A-Za-z-]", "^[a-z0-9][a-z0-9-]{0,61}[a-z0-9]$", true, "global"},
-	"azurerm_mssql_server":                                             {"azurerm_mssql_server", "sql", 1, 63, true, "[^0-9A-Za-z-]", "^[a-z0-9][a-z0-9-]{0,61}[a-z0-9]$", true, "global"},
-	"azurerm_mysql_database":                                           {"azurerm_mysql_database", "mysqldb", 1, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,63}$", true, "parent"},
-	"azurerm_mysql_firewall_rule":                                      {"azurerm_mysql_firewall_rule", "mysqlfw", 1, 128, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent"},
-	"azurerm_mysql_flexible_server":                                    {"azurerm_mysql_flexible_server", "mysqlf", 3, 63, false, "[^0-9A-Za-z-]", "^[a-z0-9][a-zA-Z0-9-]{1,61}[a-z0-9]$", true, "global"},
-	"azurerm_mysql_flexible_server_database":                           {"azurerm_mysql_flexible_server_database", "mysqlfdb", 1, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,63}$", true, "parent"},
-	"azurerm_mysql_flexible_server_firewall_rule":                      {"azurerm_mysql_flexible_server_firewall_rule", "mysqlffw", 1, 128, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent"},
-	"azurerm_mysql_server":                                             {"azurerm_mysql_server", "mysql", 3, 63, false, "[^0-9A-Za-z-]", "^[a-z0-9][a-zA-Z0-9-]{1,61}[a-z0-9]$", true, "global"},
-	"azurerm_mysql_virtual_network_rule":                               {"azurerm_mysql_virtual_network_rule", "mysqlvn", 1, 128, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent"},
-	"azurerm_nat_gateway":                                              {"azurerm_nat_gateway", "ng", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_netapp_account":                                           {"azurerm_netapp_account", "ana", 1, 128, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9][a-zA-Z0-9-_]{0,126}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_netapp_pool":                                              {"azurerm_netapp_pool", "anp", 1, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9][a-zA-Z0-9-_]{0,61}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_netapp_snapshot":                                          {"azurerm_netapp_snapshot", "ans", 1, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9][a-zA-Z0-9-_]{0,61}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_netapp_volume":                                            {"azurerm_netapp_volume", "anv", 1, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9][a-zA-Z0-9-_]{0,61}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_network_connection_monitor":                               {"azurerm_network_connection_monitor", "cm", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_network_ddos_protection_plan":                             {"azurerm_network_ddos_protection_plan", "ddospp", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_network_interface":                                        {"azurerm_network_interface", "nic", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_network_profile":                                          {"azurerm_network_profile", "npr", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_network_security_group":                                   {"azurerm_network_security_group", "nsg", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_network_security_group_rule":                              {"azurerm_network_security_group_rule", "nsgr", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_network_security_rule":                                    {"azurerm_network_security_rule", "nsgr", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_network_watcher":                                          {"azurerm_network_watcher", "nw", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_nginx_deployment":                                         {"azurerm_nginx_deployment", "nginx", 1, 30, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,28}[a-zA-Z0-9])?$", true, "resourceGroup"},
-	"azurerm_notification_hub":                                         {"azurerm_notification_hub", "nh", 1, 260, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,259}$", true, "parent"},
-	"azurerm_notification_hub_authorization_rule":                      {"azurerm_notification_hub_authorization_rule", "dnsrec", 1, 256, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,255}$", true, "parent"},
-	"azurerm_notification_hub_namespace":                               {"azurerm_notification_hub_namespace", "dnsrec", 6, 50, false, "[^0-9A-Za-z-]", "^[a-zA-Z][a-zA-Z0-9-]{4,48}[a-zA-Z0-9]$", true, "global"},
-	"azurerm_orchestrated_virtual_machine_scale_set":                   {"azurerm_orchestrated_virtual_machine_scale_set", "ovmss", 1, 64, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,62}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_point_to_site_vpn_gateway":                                {"azurerm_point_to_site_vpn_gateway", "vpngw", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_policy_definition":                                        {"azurerm_policy_definition", "pold", 1, 64, false, "[^0-9A-Za-z-_.()]", "^[a-zA-Z0-9-_.()]{1,64}$", true, "parent"},
-	"azurerm_policy_remediation":                                       {"azurerm_policy_remediation", "polr", 1, 64, false, "[^0-9A-Za-z-_.()]", "^[a-zA-Z0-9-_.()]{1,64}$", true, "parent"},
-	"azurerm_policy_set_definition":                                    {"azurerm_policy_set_definition", "polsd", 1, 64, false, "[^0-9A-Za-z-_.()]", "^[a-zA-Z0-9-_.()]{1,64}$", true, "parent"},
-	"azurerm_portal_dashboard":                                         {"azurerm_portal_dashboard", "dsb", 3, 160, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{3,160}$", true, "parent"},
-	"azurerm_postgresql_database":                                      {"azurerm_postgresql_database", "psqldb", 1, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,63}$", true, "parent"},
-	"azurerm_postgresql_firewall_rule":                                 {"azurerm_postgresql_firewall_rule", "psqlfw", 1, 128, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent"},
-	"azurerm_postgresql_flexible_server":                               {"azurerm_postgresql_flexible_server", "psqlf", 3, 63, true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$", true, "global"},
-	"azurerm_postgresql_flexible_server_database":                      {"azurerm_postgresql_flexible_server_database", "psqlfdb", 1, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,63}$", true, "parent"},
-	"azurerm_postgresql_flexible_server_firewall_rule":                 {"azurerm_postgresql_flexible_server_firewall_rule", "psqlffw", 1, 128, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent"},
-	"azurerm_postgresql_server":                                        {"azurerm_postgresql_server", "psql", 3, 63, false, "[^0-9A-Za-z-]", "^[a-z0-9][a-zA-Z0-9-]{1,61}[a-z0-9]$", true, "global"},
-	"azurerm_postgresql_virtual_network_rule":                          {"azurerm_postgresql_virtual_network_rule", "psqlvn", 1, 128, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent"},
-	"azurerm_powerbi_embedded":                                         {"azurerm_powerbi_embedded", "pbi", 3, 63, false, "[^0-9a-z]", "^[a-z0-9][a-z0-9]{2,62}$", false, "region"},
-	"azurerm_private_dns_a_record":                                     {"azurerm_private_dns_a_record", "pdnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_private_dns_aaaa_record":                                  {"azurerm_private_dns_aaaa_record", "pdnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_private_dns_cname_record":                                 {"azurerm_private_dns_cname_record", "pdnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_private_dns_mx_record":                                    {"azurerm_private_dns_mx_record", "pdnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_private_dns_ptr_record":                                   {"azurerm_private_dns_ptr_record", "pdnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_private_dns_resolver":                                     {"azurerm_private_dns_resolver", "dnspr", 3, 80, false, "[^0-9A-Za-z_-]", "^[a-zA-Z][a-zA-Z0-9-_]{1,78}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_private_dns_resolver_dns_forwarding_ruleset":              {"azurerm_private_dns_resolver_dns_forwarding_ruleset", "dnsfwrs", 2, 80, false, "[^0-9A-Za-z_-]", "^[a-zA-Z][a-zA-Z0-9-_]{0,78}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_private_dns_resolver_forwarding_rule":                     {"azurerm_private_dns_resolver_forwarding_rule", "dnsfwr", 1, 80, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9]([a-zA-Z0-9-_]{0,78}[a-zA-Z0-9])?$", true, "parent"},
-	"azurerm_private_dns_resolver_inbound_endpoint":                    {"azurerm_private_dns_resolver_inbound_endpoint", "dnsprie", 3, 80, false, "[^0-9A-Za-z_-]", "^[a-zA-Z][a-zA-Z0-9-_]{1,78}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_private_dns_resolver_outbound_endpoint":                   {"azurerm_private_dns_resolver_outbound_endpoint", "dnsproe", 3, 80, false, "[^0-9A-Za-z_-]", "^[a-zA-Z][a-zA-Z0-9-_]{1,78}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_private_dns_resolver_virtual_network_link":                {"azurerm_private_dns_resolver_virtual_network_link", "dnsfwrsvnetl", 1, 80, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9]([a-zA-Z0-9-_]{0,78}[a-zA-Z0-9])?$", true, "parent"},
-	"azurerm_private_dns_srv_record":                                   {"azurerm_private_dns_srv_record", "pdnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_private_dns_txt_record":                                   {"azurerm_private_dns_txt_record", "pdnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_private_dns_zone":                                         {"azurerm_private_dns_zone", "pdns", 1, 63, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,61}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_private_dns_zone_group":                                   {"azurerm_private_dns_zone_group", "pdnszg", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_private_dns_zone_virtual_network_link":                    {"azurerm_private_dns_zone_virtual_network_link", "pnetlk", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_private_endpoint":                                         {"azurerm_private_endpoint", "pe", 2, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,62}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_private_link_service":                                     {"azurerm_private_link_service", "pls", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_private_service_connection":                               {"azurerm_private_service_connection", "psc", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_proximity_placement_group":                                {"azurerm_proximity_placement_group", "ppg", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_public_ip":                                                {"azurerm_public_ip", "pip", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_public_ip_prefix":                                         {"azurerm_public_ip_prefix", "pippf", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_purview_account":                                          {"azurerm_purview_account", "purv", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9_-]$", true, "subscription"},
-	"azurerm_recovery_services_vault":                                  {"azurerm_recovery_services_vault", "rsv", 2, 50, false, `[^a-zA-Z0-9-]`, "^[a-zA-Z][a-zA-Z0-9-]{1,49}$", true, "resourceGroup"},
-	"azurerm_recovery_services_vault_backup_police":                    {"azurerm_recovery_services_vault_backup_police", "rsvbp", 3, 150, false, `[^a-zA-Z0-9-]`, "^[a-zA-Z][a-zA-Z0-9\\-]{1,148}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_redhat_openshift_cluster":                                 {"azurerm_redhat_openshift_cluster", "aroc", 1, 30, false, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{1,30}$", false, "resourceGroup"},
-	"azurerm_redhat_openshift_domain":                                  {"azurerm_redhat_openshift_domain", "arod", 1, 30, false, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{1,30}$", false, "resourceGroup"},
-	"azurerm_redis_cache":                                              {"azurerm_redis_cache", "redis", 1, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,61}[a-zA-Z0-9]$", true, "global"},
-	"azurerm_redis_firewall_rule":                                      {"azurerm_redis_firewall_rule", "redisfw", 1, 256, false, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{1,256}$", false, "parent"},
-	"azurerm_relay_hybrid_connection":                                  {"azurerm_relay_hybrid_connection", "rlhc", 1, 260, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,258}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_relay_namespace":                                          {"azurerm_relay_namespace", "rln", 6, 50, false, "[^0-9A-Za-z-]", "^[a-zA-Z][a-zA-Z0-9-]{4,48}[a-zA-Z0-9]$", true, "global"},
-	"azurerm_resource_group":                                           {"azurerm_resource_group", "rg", 1, 90, false, `[^a-zA-Z0-9-._\\(\\)]`, "^[a-zA-Z0-9-._\\(\\)]{0,89}[a-zA-Z0-9-_\\(\\)]$", true, "subscription"},
-	"azurerm_resource_group_policy_assignment":                         {"azurerm_resource_group_policy_assignment", "argpa", 1, 128, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,126}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_resource_group_template_deployment":                       {"azurerm_resource_group_template_deployment", "rgtd", 1, 64, false, "[^0-9A-Za-z-_.()]", "^[a-zA-Z0-9-_.()]{1,64}$", true, "resourceGroup"},
-	"azurerm_role_assignment":                                          {"azurerm_role_assignment", "ra", 1, 64, false, "[^0-9A-Za-z_.-]", "^[^%]{0,63}[^ %.]$", true, "assignment"},
-	"azurerm_role_definition":                                          {"azurerm_role_definition", "rd", 1, 64, false, "[^0-9A-Za-z_.-]", "^[^%]{0,63}[^ %.]$", true, "definition"},
-	"azurerm_route":                                                    {"azurerm_route", "rt", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_route_filter":                                             {"azurerm_route_filter", "rf", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_route_server":                                             {"azurerm_route_server", "rts", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_route_table":                                              {"azurerm_route_table", "route", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_search_service":                                           {"azurerm_search_service", "srch", 2, 60, true, "[a-z0-9-]*--[a-z0-9-]*$", "^[a-z0-9](?:[a-z0-9-]{0,58}[a-z0-9])?$", true, "global"},
-	"azurerm_security_center_automation":                               {"azurerm_security_center_automation", "sca", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_security_center_contact":                                  {"azurerm_security_center_contact", "scc", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_sentinel_alert_rule_ms_security_incident":                 {"azurerm_sentinel_alert_rule_ms_security_incident", "sentarms", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_sentinel_alert_rule_scheduled":                            {"azurerm_sentinel_alert_rule_scheduled", "sentars", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_service_fabric_cluster":                                   {"azurerm_service_fabric_cluster", "sf", 4, 23, true, "[^0-9a-z-]", "^[a-z][a-z0-9-]{2,21}[a-z0-9]$", true, "region"},
-	"azurerm_service_fabric_mesh_application":                          {"azurerm_service_fabric_mesh_application", "sfmesha", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_service_fabric_mesh_local_network":                        {"azurerm_service_fabric_mesh_local_network", "sfmeshln", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_service_fabric_mesh_secret":                               {"azurerm_service_fabric_mesh_secret", "sfmeshs", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_service_plan":                                             {"azurerm_service_plan", "asp", 1, 40, false, "[^0-9A-Za-z-]", "^[0-9A-Za-z-]{1,40}$", true, "resourceGroup"},
-	"azurerm_servicebus_namespace":                                     {"azurerm_servicebus_namespace", "sb", 6, 50, false, "[^0-9A-Za-z-]", "^[a-zA-Z][a-zA-Z0-9-]{4,48}[a-zA-Z0-9]$", true, "global"},
-	"azurerm_servicebus_namespace_authorization_rule":                  {"azurerm_servicebus_namespace_authorization_rule", "sbar", 1, 50, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_servicebus_namespace_disaster_recovery_config":            {"azurerm_servicebus_namespace_disaster_recovery_config", "sbdr", 1, 50, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_servicebus_queue":                                         {"azurerm_servicebus_queue", "sbq", 1, 260, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,258}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_servicebus_queue_authorization_rule":                      {"azurerm_servicebus_queue_authorization_rule", "sbqar", 1, 50, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_servicebus_subscription":                                  {"azurerm_servicebus_subscription", "sbs", 1, 50, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_servicebus_subscription_rule":                             {"azurerm_servicebus_subscription_rule", "sbsr", 1, 50, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_servicebus_topic":                                         {"azurerm_servicebus_topic", "sbt", 1, 260, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,258}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_servicebus_topic_authorization_rule":                      {"azurerm_servicebus_topic_authorization_rule", "sbtar", 1, 50, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent"},
-	"azurerm_shared_image":                                             {"azurerm_shared_image", "si", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_shared_image_gallery":                                     {"azurerm_shared_image_gallery", "sig", 1, 80, false, "[^0-9A-Za-z_.]", "^[a-zA-Z0-9][a-zA-Z0-9._]{0,78}[a-zA-Z0-9]$", false, "resourceGroup"},
-	"azurerm_signalr_service":                                          {"azurerm_signalr_service", "sgnlr", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "global"},
-	"azurerm_site_recovery_fabric":                                     {"azurerm_site_recovery_fabric", "asrf", 1, 128, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,126}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_site_recovery_protection_container":                       {"azurerm_site_recovery_protection_container", "asrpc", 1, 128, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,126}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_site_recovery_replicated_vm":                              {"azurerm_site_recovery_replicated_vm", "asrrvm", 1, 128, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,126}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_site_recovery_replication_policy":                         {"azurerm_site_recovery_replication_policy", "asrrp", 1, 128, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,126}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_snapshot":                                                 {"azurerm_snapshot", "snp", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_snapshots":                                                {"azurerm_snapshots", "snap", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_spatial_anchors_account":                                  {"azurerm_spatial_anchors_account", "spaa", 1, 90, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,88}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_spring_cloud_app":                                         {"azurerm_spring_cloud_app", "spca", 4, 32, true, "[^0-9a-z-]", "^[a-z][a-z0-9-]{2,30}[a-z0-9]$", true, "parent"},
-	"azurerm_spring_cloud_certificate":                                 {"azurerm_spring_cloud_certificate", "spcert", 1, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,62}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_spring_cloud_service":                                     {"azurerm_spring_cloud_service", "spcs", 4, 32, true, "[^0-9a-z-]", "^[a-z][a-z0-9-]{2,30}[a-z0-9]$", true, "resourceGroup"},
-	"azurerm_sql_database":                                             {"azurerm_sql_database", "sqld", 1, 128, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,126}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_sql_elasticpool":                                          {"azurerm_sql_elasticpool", "sqlep", 1, 128, false, "[<>*%:.?\\+\\/]", "^[^<>*%:.?\\+\\/]{1,127}[^<>*%:.?\\+\\/ ]$", true, "parent"},
-	"azurerm_sql_failover_group":                                       {"azurerm_sql_failover_group", "sqlfg", 1, 63, true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{0,61}[a-z0-9]$", true, "global"},
-	"azurerm_sql_firewall_rule":                                        {"azurerm_sql_firewall_rule", "sqlfw", 1, 128, false, `[<>*%:?\\+\\/]`, "^[^<>*%:?\\+\\/]{1,127}[^<>*%:.?\\+\\/]$", true, "parent"},
-	"azurerm_sql_server":                                               {"azurerm_sql_server", "sql", 1, 63, true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{0,61}[a-z0-9]$", true, "global"},
-	"azurerm_static_site":                                              {"azurerm_static_site", "stapp", 1, 40, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{1,40}$", true, "resourceGroup"},
-	"azurerm_storage_account":                                          {"azurerm_storage_account", "st", 3, 24, true, "[^0-9a-z]", "^[a-z0-9]{3,24}$", false, "global"},
-	"azurerm_storage_blob":                                             {"azurerm_storage_blob", "blob", 1, 1024, false, "[\\s\\/$#&]", "^[^\\s\\/$#&]{1,1000}[^\\s\\/$#&]{0,24}$", true, "parent"},
-	"azurerm_storage_container":                                        {"azurerm_storage_container", "stct", 3, 63, false, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{2,62}$", true, "parent"},
-	"azurerm_storage_data_lake_gen2_filesystem":                        {"azurerm_storage_data_lake_gen2_filesystem", "stdl", 3, 63, false, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$", true, "parent"},
-	"azurerm_storage_data_lake_gen2_path":                              {"azurerm_storage_data_lake_gen2_path", "stdlp", 1, 1024, false, "[\\s$#&]", "^[^\\s\\/$#&]{1,1000}[^\\s\\/$#&]{0,24}$", true, "parent"},
-	"azurerm_storage_encryption_scope":                                 {"azurerm_storage_encryption_scope", "stes", 4, 63, false, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{4,63}$", false, "parent"},
-	"azurerm_storage_queue":                                            {"azurerm_storage_queue", "stq", 3, 63, false, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$", true, "parent"},
-	"azurerm_storage_share":                                            {"azurerm_storage_share", "sts", 3, 63, false, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$", true, "parent"},
-	"azurerm_storage_share_directory":                                  {"azurerm_storage_share_directory", "sts", 3, 63, false, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$", true, "parent"},
-	"azurerm_storage_sync":                                             {"azurerm_storage_sync", "stsy", 1, 260, false, "[<>*%:.?\\+\\/]", "^[^<>*%:.?\\+\\/]{0,259}[^<>*%:.?\\+\\/ ]$", true, "resourceGroup"},
-	"azurerm_storage_sync_group":                                       {"azurerm_storage_sync_group", "stsg", 1, 260, false, "[<>*%:.?\\+\\/]", "^[^<>*%:.?\\+\\/]{0,259}[^<>*%:.?\\+\\/ ]$", true, "resourceGroup"},
-	"azurerm_storage_table":                                            {"azurerm_storage_table", "stt", 3, 63, false, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$", true, "parent"},
-	"azurerm_stream_analytics_function_javascript_udf":                 {"azurerm_stream_analytics_function_javascript_udf", "asafunc", 3, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent"},
-	"azurerm_stream_analytics_job":                                     {"azurerm_stream_analytics_job", "asa", 3, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "resourceGroup"},
-	"azurerm_stream_analytics_output_blob":                             {"azurerm_stream_analytics_output_blob", "asaoblob", 3, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent"},
-	"azurerm_stream_analytics_output_eventhub":                         {"azurerm_stream_analytics_output_eventhub", "asaoeh", 3, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent"},
-	"azurerm_stream_analytics_output_mssql":                            {"azurerm_stream_analytics_output_mssql", "asaomssql", 3, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent"},
-	"azurerm_stream_analytics_output_servicebus_queue":                 {"azurerm_stream_analytics_output_servicebus_queue", "asaosbq", 3, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent"},
-	"azurerm_stream_analytics_output_servicebus_topic":                 {"azurerm_stream_analytics_output_servicebus_topic", "asaosbt", 3, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent"},
-	"azurerm_stream_analytics_reference_input_blob":                    {"azurerm_stream_analytics_reference_input_blob", "asarblob", 3, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent"},
-	"azurerm_stream_analytics_stream_input_blob":                       {"azurerm_stream_analytics_stream_input_blob", "asaiblob", 3, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent"},
-	"azurerm_stream_analytics_stream_input_eventhub":                   {"azurerm_stream_analytics_stream_input_eventhub", "asaieh", 3, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent"},
-	"azurerm_stream_analytics_stream_input_iothub":                     {"azurerm_stream_analytics_stream_input_iothub", "asaiiot", 3, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent"},
-	"azurerm_subnet":                                                   {"azurerm_subnet", "snet", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_subscription_policy_assignment":                           {"azurerm_subscription_policy_assignment", "aspa", 1, 128, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,126}[a-zA-Z0-9_]$", true, "subscription"},
-	"azurerm_subscription_template_deployment":                         {"azurerm_subscription_template_deployment", "subtd", 1, 64, false, "[^0-9A-Za-z-_.()]", "^[a-zA-Z0-9-_.()]{1,64}$", true, "parent"},
-	"azurerm_synapse_firewall_rule":                                    {"azurerm_synapse_firewall_rule", "syfw", 1, 128, false, `[<>*%:?\\+\\/]`, "^[^<>*%:?\\+\\/]{1,127}[^<>*%:.?\\+\\/]$", true, "parent"},
-	"azurerm_synapse_integration_runtime_azure":                        {"azurerm_synapse_integration_runtime_azure", "synira", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9-]$", true, "subscription"},
-	"azurerm_synapse_integration_runtime_self_hosted":                  {"azurerm_synapse_integration_runtime_self_hosted", "synirsh", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9_-]$", true, "subscription"},
-	"azurerm_synapse_linked_service":                                   {"azurerm_synapse_linked_service", "synls", 1, 140, false, "[^0-9A-Za-z_]", "^[a-zA-Z0-9_]{1,140}$", false, "subscription"},
-	"azurerm_synapse_managed_private_endpoint":                         {"azurerm_synapse_managed_private_endpoint", "synmpe", 3, 63, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9_.-]{1,61}[a-zA-Z0-9_]$", true, "subscription"},
-	"azurerm_synapse_private_link_hub":                                 {"azurerm_synapse_private_link_hub", "synplh", 1, 45, true, "[^0-9a-z]", "^[a-z0-9]{1,45}$", false, "subscription"},
-	"azurerm_synapse_spark_pool":                                       {"azurerm_synapse_spark_pool", "sysp", 1, 15, true, "[^0-9a-zA-Z]", "^[0-9a-zA-Z]{1,15}$", false, "parent"},
-	"azurerm_synapse_sql_pool":                                         {"azurerm_synapse_sql_pool", "synsp", 3, 15, false, "[^0-9A-Za-z_]", "^[a-zA-Z0-9][a-zA-Z0-9_]{1,13}[a-zA-Z0-9]$", false, "subscription"},
-	"azurerm_synapse_sql_pool_vulnerability_assessment_baseline":       {"azurerm_synapse_sql_pool_vulnerability_assessment_baseline", "synspvab", 3, 63, false, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{1,63}$", false, "subscription"},
-	"azurerm_synapse_sql_pool_workload_classifier":                     {"azurerm_synapse_sql_pool_workload_classifier", "synspwc", 1, 128, false, `[^-\w\._\(\)]`, "^[^<>*%:?\\+\\/]{1,127}[^<>*%:.?\\+\\/]$", true, "subscription"},
-	"azurerm_synapse_sql_pool_workload_group":                          {"azurerm_synapse_sql_pool_workload_group", "synspwg", 1, 128, false, `[^-\w\._\(\)]`, "^[^<>*%:.?\\+\\/]{0,127}[^<>*%:.?\\+\\/ ]$", true, "subscription"},
-	"azurerm_synapse_workspace":                                        {"azurerm_synapse_workspace", "syws", 1, 45, true, "[^0-9a-z]", "^[0-9a-z]{1,45}$", false, "resourceGroup"},
-	"azurerm_template_deployment":                                      {"azurerm_template_deployment", "deploy", 1, 64, false, "[^0-9A-Za-z-._\\(\\)]", "^[a-zA-Z0-9-._\\(\\)]{1,64}$", true, "resourceGroup"},
-	"azurerm_traffic_manager_profile":                                  {"azurerm_traffic_manager_profile", "traf", 1, 63, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-.]{0,61}[a-zA-Z0-9_]$", true, "global"},
-	"azurerm_user_assigned_identity":                                   {"azurerm_user_assigned_identity", "msi", 3, 128, true, "[^0-9a-zA-Z-_]", "^[0-9a-zA-Z][0-9a-zA-Z-_]{2,127}$", true, "parent"},
-	"azurerm_virtual_desktop_application_group":                        {"azurerm_virtual_desktop_application_group", "dag", 1, 260, false, "[^0-9A-Za-z _.-]", "^[a-zA-Z0-9 ][a-zA-Z0-9-._ ]{0,258}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_virtual_desktop_host_pool":                                {"azurerm_virtual_desktop_host_pool", "hpool", 1, 260, false, "[^0-9A-Za-z _.-]", "^[a-zA-Z0-9 ][a-zA-Z0-9-._ ]{0,258}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_virtual_desktop_workspace":                                {"azurerm_virtual_desktop_workspace", "wvdws", 1, 260, false, "[^0-9A-Za-z _.-]", "^[a-zA-Z0-9 ][a-zA-Z0-9-._ ]{0,258}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_virtual_hub":                                              {"azurerm_virtual_hub", "vhub", 1, 50, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_virtual_hub_bgp_connection":                               {"azurerm_virtual_hub_bgp_connection", "vhbgp", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_virtual_hub_connection":                                   {"azurerm_virtual_hub_connection", "vhcon", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_virtual_hub_ip":                                           {"azurerm_virtual_hub_ip", "vhip", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_virtual_hub_route_table":                                  {"azurerm_virtual_hub_route_table", "vhrt", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_virtual_hub_security_partner_provider":                    {"azurerm_virtual_hub_security_partner_provider", "vhspp", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_virtual_machine":                                          {"azurerm_virtual_machine", "vm", 1, 15, false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,13}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup"},
-	"azurerm_virtual_machine_extension":                                {"azurerm_virtual_machine_extension", "vmx", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_virtual_machine_portal_name":                              {"azurerm_virtual_machine_portal_name", "vm", 1, 64, false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,62}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup"},
-	"azurerm_virtual_machine_scale_set":                                {"azurerm_virtual_machine_scale_set", "vmss", 1, 15, false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,13}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup"},
-	"azurerm_virtual_machine_scale_set_extension":                      {"azurerm_virtual_machine_scale_set_extension", "vmssx", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_virtual_network":                                          {"azurerm_virtual_network", "vnet", 2, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,62}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_virtual_network_gateway":                                  {"azurerm_virtual_network_gateway", "vgw", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_virtual_network_gateway_connection":                       {"azurerm_virtual_network_gateway_connection", "vngc", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_virtual_network_peering":                                  {"azurerm_virtual_network_peering", "vpeer", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_virtual_wan":                                              {"azurerm_virtual_wan", "vwan", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_vm_windows_computer_name_prefix":                          {"azurerm_vm_windows_computer_name_prefix", "cn", 1, 9, false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,7}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup"},
-	"azurerm_vmware_cluster":                                           {"azurerm_vmware_cluster", "vwc", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_vmware_express_route_authorization":                       {"azurerm_vmware_express_route_authorization", "vwera", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_vmware_private_cloud":                                     {"azurerm_vmware_private_cloud", "vwpc", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_vpn_gateway":                                              {"azurerm_vpn_gateway", "vpng", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_vpn_gateway_connection":                                   {"azurerm_vpn_gateway_connection", "vcn", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_vpn_server_configuration":                                 {"azurerm_vpn_server_configuration", "vpnsc", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup"},
-	"azurerm_vpn_site":                                                 {"azurerm_vpn_site", "vst", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent"},
-	"azurerm_web_application_firewall_policy":                          {"azurerm_web_application_firewall_policy", "wafw", 1, 80, false, "[^0-9A-Za-z]", "^[a-zA-Z0-9][a-zA-Z0-9]{0,78}[a-zA-Z0-9]$", false, "global"},
-	"azurerm_web_pubsub":                                               {"azurerm_web_pubsub", "ps", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z][-a-zA-Z0-9]{1,61}[a-zA-Z0-9]$", true, "resourceGroup"},
-	"azurerm_web_pubsub_hub":                                           {"azurerm_web_pubsub_hub", "pshub", 1, 128, false, "[^0-9A-Za-z_`,.\\[\\]]", "^[a-zA-Z][a-zA-Z0-9_`,.\\[\\]]{0,127}$", false, "parent"},
-	"azurerm_windows_function_app":                                     {"azurerm_windows_function_app", "fa", 2, 60, false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,58}[0-9a-zA-Z]$", true, "global"},
-	"azurerm_windows_function_app_slot":                                {"azurerm_windows_function_app_slot", "fas", 2, 59, false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,57}[0-9a-zA-Z]$", true, "global"},
-	"azurerm_windows_virtual_machine":                                  {"azurerm_windows_virtual_machine", "vm", 1, 15, false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,13}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup"},
-	"azurerm_windows_virtual_machine_scale_set":                        {"azurerm_windows_virtual_machine_scale_set", "vmss", 1, 15, false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,13}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup"},
-	"azurerm_windows_web_app":                                          {"azurerm_windows_web_app", "wwapp", 2, 60, false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,58}[0-9a-zA-Z]$", true, "global"},
-	"databricks_cluster":                                               {"databricks_cluster", "dbc", 3, 30, false, "[^a-zA-Z0-9-_]", "^[a-zA-Z0-9-_]{3,30}$", true, "parent"},
-	"databricks_high_concurrency_cluster":                              {"databricks_high_concurrency_cluster", "dbhcc", 3, 30, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,30}$", true, "parent"},
-	"databricks_standard_cluster":                                      {"databricks_standard_cluster", "dbsc", 3, 30, false, "[^a-zA-Z0-9-_]", "^[a-zA-Z0-9-_]{3,30}$", true, "parent"},
-	"general":                                                          {"general", "", 1, 250, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,250}$", true, "global"},
-	"general_safe":                                                     {"general_safe", "", 1, 250, true, "[^a-z]", "^[a-z]{1,250}$", false, "global"},
+	"aks_node_pool_linux":                                              {"aks_node_pool_linux", "npl", 1, 12, false, "[^0-9a-z]", "^[a-z][0-9a-z]{0,11}$", false, "parent", false, OfficialData{"", "Azure Aks Node Pool Linux", ""}, nil, false},
+	"aks_node_pool_windows":                                            {"aks_node_pool_windows", "npw", 1, 6, false, "[^0-9a-z]", "^[a-z][0-9a-z]{0,5}$", false, "parent", false, OfficialData{"", "Azure Aks Node Pool Windows", ""}, nil, false},
+	"azurerm_aadb2c_directory":                                         {"azurerm_aadb2c_directory", "aadb2c", 1, 75, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,73}[a-zA-Z0-9]$", true, "global", false, OfficialData{"aadb2c", "Azure Active Directory B2C tenant", "Microsoft.AzureActiveDirectory/b2cDirectories"}, nil, false},
+	"azurerm_analysis_services_server":                                 {"azurerm_analysis_services_server", "as", 3, 63, true, "[^0-9a-z]", "^[a-z][a-z0-9]{2,62}$", false, "resourceGroup", false, OfficialData{"", "Azure Analysis Services Server", ""}, nil, false},
+	"azurerm_api_management":                                           {"azurerm_api_management", "apim", 1, 50, false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,48}[a-zA-Z0-9|]$", true, "global", false, OfficialData{"apim", "API Management service instance", "Microsoft.ApiManagement/service"}, nil, true},
+	"azurerm_api_management_api":                                       {"azurerm_api_management_api", "apimapi", 1, 80, false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,78}[a-zA-Z0-9|]$", true, "global", false, OfficialData{"", "Azure Api Management Api", ""}, nil, false},
+	"azurerm_api_management_api_operation_tag":                         {"azurerm_api_management_api_operation_tag", "apimapiopt", 1, 80, false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,78}[a-zA-Z0-9|]$", true, "global", false, OfficialData{"", "Azure Api Management Api Operation Tag", ""}, nil, false},
+	"azurerm_api_management_backend":                                   {"azurerm_api_management_backend", "apimbe", 1, 80, false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,78}[a-zA-Z0-9|]$", true, "global", false, OfficialData{"", "Azure Api Management Backend", ""}, nil, false},
+	"azurerm_api_management_certificate":                               {"azurerm_api_management_certificate", "apimcer", 1, 80, false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,78}[a-zA-Z0-9|]$", true, "global", false, OfficialData{"", "Azure Api Management Certificate", ""}, nil, false},
+	"azurerm_api_management_gateway":                                   {"azurerm_api_management_gateway", "apimgw", 1, 80, false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,78}[a-zA-Z0-9|]$", true, "global", false, OfficialData{"", "Azure Api Management Gateway", ""}, nil, false},
+	"azurerm_api_management_group":                                     {"azurerm_api_management_group", "apimgr", 1, 80, false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,78}[a-zA-Z0-9|]$", true, "global", false, OfficialData{"", "Azure Api Management Group", ""}, nil, false},
+	"azurerm_api_management_logger":                                    {"azurerm_api_management_logger", "apimlg", 1, 80, false, "[^a-zA-Z0-9-]", "^[a-zA-Z][a-zA-Z0-9-]{0,78}[a-zA-Z0-9|]$", true, "global", false, OfficialData{"", "Azure Api Management Logger", ""}, nil, false},
+	"azurerm_api_management_service":                                   {"azurerm_api_management_service", "apim", 1, 50, false, "[^0-9A-Za-z-]", "^[a-z][a-zA-Z0-9-]{0,48}[a-zA-Z0-9]$", true, "global", false, OfficialData{"apim", "API Management service instance", "Microsoft.ApiManagement/service"}, nil, false},
+	"azurerm_app_configuration":                                        {"azurerm_app_configuration", "appcg", 5, 50, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{5,50}$", true, "resourceGroup", false, OfficialData{"", "Azure App Configuration", ""}, nil, false},
+	"azurerm_app_service":                                              {"azurerm_app_service", "app", 2, 60, false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,58}[0-9a-zA-Z]$", true, "global", false, OfficialData{"app", "App Service", "Microsoft.Web/sites"}, nil, false},
+	"azurerm_app_service_certificate":                                  {"azurerm_app_service_certificate", "appcert", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure App Service Certificate", ""}, nil, false},
+	"azurerm_app_service_environment":                                  {"azurerm_app_service_environment", "ase", 2, 36, false, "[^0-9A-Za-z-]", "^[0-9A-Za-z-]{2,36}$", true, "resourceGroup", false, OfficialData{"ase", "App Service Environment", "Microsoft.Web/hostingEnvironments"}, nil, false},
+	"azurerm_app_service_plan":                                         {"azurerm_app_service_plan", "plan", 1, 40, false, "[^0-9A-Za-z-]", "^[0-9A-Za-z-]{1,40}$", true, "resourceGroup", false, OfficialData{"plan", "App Service plan", "Microsoft.Web/serverfarms"}, nil, false},
+	"azurerm_app_service_slot":                                         {"azurerm_app_service_slot", "apps", 1, 59, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,57}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure App Service Slot", ""}, nil, false},
+	"azurerm_application_gateway":                                      {"azurerm_application_gateway", "agw", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"agw", "Application gateway", "Microsoft.Network/applicationGateways"}, nil, false},
+	"azurerm_application_insights":                                     {"azurerm_application_insights", "appi", 1, 260, false, "[^0-9A-Za-z_.-]", "^[^%&\\?/. ][^%&\\?/]{0,258}[^%&\\?/. ]$", true, "resourceGroup", false, OfficialData{"appi", "Application Insights", "Microsoft.Insights/components"}, nil, false},
+	"azurerm_application_insights_web_test":                            {"azurerm_application_insights_web_test", "appiwt", 1, 64, false, "[^0-9A-Za-z- ]", "^[a-zA-Z][a-zA-Z0-9- ]{0,62}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Application Insights Web Test", ""}, nil, false},
+	"azurerm_application_security_group":                               {"azurerm_application_security_group", "asg", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"asg", "Application security group", "Microsoft.Network/applicationSecurityGroups"}, nil, false},
+	"azurerm_automation_account":                                       {"azurerm_automation_account", "aa", 6, 50, false, "[^0-9A-Za-z_-]", "^[a-zA-Z][a-zA-Z0-9-]{4,48}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"aa", "Azure Automation", "Microsoft.Automation/automationAccounts"}, nil, false},
+	"azurerm_automation_certificate":                                   {"azurerm_automation_certificate", "aacert", 1, 128, false, `[^-\w\._\(\)]`, "^[^<>*%:.?\\+\\/]{0,127}[^<>*%:.?\\+\\/ ]$", true, "parent", false, OfficialData{"", "Azure Automation Certificate", ""}, nil, false},
+	"azurerm_automation_connection":                                    {"azurerm_automation_connection", "aacon", 1, 128, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,126}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Automation Connection", ""}, nil, false},
+	"azurerm_automation_credential":                                    {"azurerm_automation_credential", "aacred", 1, 128, false, `[^-\w\._\(\)]`, "^[^<>*%:.?\\+\\/]{0,127}[^<>*%:.?\\+\\/ ]$", true, "parent", false, OfficialData{"", "Azure Automation Credential", ""}, nil, false},
+	"azurerm_automation_dsc_configuration":                             {"azurerm_automation_dsc_configuration", "aadsc", 1, 128, false, "[^A-Za-z0-9_]", "^[a-zA-Z][a-zA-Z0-9_]{0,127}$", false, "parent", false, OfficialData{"", "Azure Automation Dsc Configuration", ""}, nil, false},
+	"azurerm_automation_hybrid_runbook_worker_group":                   {"azurerm_automation_hybrid_runbook_worker_group", "aahwg", 1, 128, false, "[<>*%&:\\?.+/#]", "^([^<>*%&:\\?.+/#\\s]?[ ]?){0,127}[^<>*%&:\\?.+/#\\s]$", true, "parent", false, OfficialData{"", "Azure Automation Hybrid Runbook Worker Group", ""}, nil, false},
+	"azurerm_automation_job_schedule":                                  {"azurerm_automation_job_schedule", "aajs", 1, 128, false, `[^-\w\._\(\)]`, "^[^<>*%:.?\\+\\/]{0,127}[^<>*%:.?\\+\\/ ]$", true, "parent", false, OfficialData{"", "Azure Automation Job Schedule", ""}, nil, false},
+	"azurerm_automation_module":                                        {"azurerm_automation_module", "aamod", 1, 128, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,126}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Automation Module", ""}, nil, false},
+	"azurerm_automation_runbook":                                       {"azurerm_automation_runbook", "aarun", 1, 63, false, "[^0-9A-Za-z_]", "^[a-zA-Z][a-zA-Z0-9-]{0,62}$", true, "parent", false, OfficialData{"", "Azure Automation Runbook", ""}, nil, false},
+	"azurerm_automation_schedule":                                      {"azurerm_automation_schedule", "aasched", 1, 128, false, `[^-\w\._\(\)]`, "^[^<>*%:.?\\+\\/]{0,127}[^<>*%:.?\\+\\/ ]$", true, "parent", false, OfficialData{"", "Azure Automation Schedule", ""}, nil, false},
+	"azurerm_automation_variable":                                      {"azurerm_automation_variable", "aavar", 1, 128, false, `[^-\w\._\(\)]`, "^[^<>*%:.?\\+\\/]{0,127}[^<>*%:.?\\+\\/ ]$", true, "parent", false, OfficialData{"", "Azure Automation Variable", ""}, nil, false},
+	"azurerm_availability_set":                                         {"azurerm_availability_set", "avail", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Availability Set", ""}, nil, false},
+	"azurerm_bastion_host":                                             {"azurerm_bastion_host", "bast", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Bastion Host", ""}, nil, false},
+	"azurerm_batch_account":                                            {"azurerm_batch_account", "ba", 3, 24, true, "[^0-9a-z]", "^[a-z0-9]{3,24}$", false, "region", false, OfficialData{"", "Azure Batch Account", ""}, nil, false},
+	"azurerm_batch_application":                                        {"azurerm_batch_application", "baapp", 1, 64, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,64}$", true, "parent", false, OfficialData{"", "Azure Batch Application", ""}, nil, false},
+	"azurerm_batch_certificate":                                        {"azurerm_batch_certificate", "bacert", 5, 45, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{5,45}$", true, "parent", false, OfficialData{"", "Azure Batch Certificate", ""}, nil, false},
+	"azurerm_batch_pool":                                               {"azurerm_batch_pool", "bapool", 3, 24, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9_-]{1,24}$", true, "parent", false, OfficialData{"", "Azure Batch Pool", ""}, nil, false},
+	"azurerm_blueprint_assignment":                                     {"azurerm_blueprint_assignment", "bpa", 1, 90, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,88}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Blueprint Assignment", ""}, nil, false},
+	"azurerm_bot_channel_Email":                                        {"azurerm_bot_channel_Email", "botmail", 2, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "parent", false, OfficialData{"", "Azure Bot Channel Email", ""}, nil, false},
+	"azurerm_bot_channel_directline":                                   {"azurerm_bot_channel_directline", "botline", 2, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "parent", false, OfficialData{"", "Azure Bot Channel Directline", ""}, nil, false},
+	"azurerm_bot_channel_ms_teams":                                     {"azurerm_bot_channel_ms_teams", "botteams", 2, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "parent", false, OfficialData{"", "Azure Bot Channel Ms Teams", ""}, nil, false},
+	"azurerm_bot_channel_slack":                                        {"azurerm_bot_channel_slack", "botslack", 2, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "parent", false, OfficialData{"", "Azure Bot Channel Slack", ""}, nil, false},
+	"azurerm_bot_channels_registration":                                {"azurerm_bot_channels_registration", "botchan", 2, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "parent", false, OfficialData{"", "Azure Bot Channels Registration", ""}, nil, false},
+	"azurerm_bot_connection":                                           {"azurerm_bot_connection", "botcon", 2, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "parent", false, OfficialData{"", "Azure Bot Connection", ""}, nil, false},
+	"azurerm_bot_service_azure_bot":                                    {"azurerm_bot_service_azure_bot", "botaz", 2, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "global", false, OfficialData{"", "Azure Bot Service Azure Bot", ""}, nil, false},
+	"azurerm_bot_web_app":                                              {"azurerm_bot_web_app", "bot", 2, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,63}$", true, "global", false, OfficialData{"", "Azure Bot Web App", ""}, nil, false},
+	"azurerm_cdn_endpoint":                                             {"azurerm_cdn_endpoint", "cdn", 1, 50, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,48}[a-zA-Z0-9]$", true, "global", false, OfficialData{"", "Azure Cdn Endpoint", ""}, nil, false},
+	"azurerm_cdn_frontdoor_custom_domain":                              {"azurerm_cdn_frontdoor_custom_domain", "cfdcd", 1, 260, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,258}[a-zA-Z0-9])?$", true, "parent", false, OfficialData{"", "Azure Cdn Frontdoor Custom Domain", ""}, nil, false},
+	"azurerm_cdn_frontdoor_endpoint":                                   {"azurerm_cdn_frontdoor_endpoint", "cfde", 1, 46, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,44}[a-zA-Z0-9])?$", true, "global", false, OfficialData{"", "Azure Cdn Frontdoor Endpoint", ""}, nil, false},
+	"azurerm_cdn_frontdoor_firewall_policy":                            {"azurerm_cdn_frontdoor_firewall_policy", "cfdfp", 1, 128, false, "[^0-9A-Za-z]", "^[a-zA-Z][0-9a-zA-Z]{0,127}$", false, "resourceGroup", false, OfficialData{"", "Azure Cdn Frontdoor Firewall Policy", ""}, nil, false},
+	"azurerm_cdn_frontdoor_origin":                                     {"azurerm_cdn_frontdoor_origin", "cfdo", 1, 90, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,88}[a-zA-Z0-9])?$", true, "parent", false, OfficialData{"", "Azure Cdn Frontdoor Origin", ""}, nil, false},
+	"azurerm_cdn_frontdoor_origin_group":                               {"azurerm_cdn_frontdoor_origin_group", "cfdog", 1, 90, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,88}[a-zA-Z0-9])?$", true, "parent", false, OfficialData{"", "Azure Cdn Frontdoor Origin Group", ""}, nil, false},
+	"azurerm_cdn_frontdoor_profile":                                    {"azurerm_cdn_frontdoor_profile", "cfdp", 1, 90, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,88}[a-zA-Z0-9])?$", true, "resourceGroup", false, OfficialData{"", "Azure Cdn Frontdoor Profile", ""}, nil, false},
+	"azurerm_cdn_frontdoor_route":                                      {"azurerm_cdn_frontdoor_route", "cfdroute", 1, 90, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,88}[a-zA-Z0-9])?$", true, "parent", false, OfficialData{"", "Azure Cdn Frontdoor Route", ""}, nil, false},
+	"azurerm_cdn_frontdoor_rule":                                       {"azurerm_cdn_frontdoor_rule", "cfdr", 1, 60, false, "[^0-9A-Za-z]", "^[a-zA-Z][a-zA-Z0-9]{0,59}$", false, "parent", false, OfficialData{"", "Azure Cdn Frontdoor Rule", ""}, nil, false},
+	"azurerm_cdn_frontdoor_rule_set":                                   {"azurerm_cdn_frontdoor_rule_set", "cfdrs", 1, 60, false, "[^0-9A-Za-z]", "^[a-zA-Z][a-zA-Z0-9]{0,59}$", false, "parent", false, OfficialData{"", "Azure Cdn Frontdoor Rule Set", ""}, nil, false},
+	"azurerm_cdn_frontdoor_secret":                                     {"azurerm_cdn_frontdoor_secret", "cfds", 2, 260, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,258}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Cdn Frontdoor Secret", ""}, nil, false},
+	"azurerm_cdn_frontdoor_security_policy":                            {"azurerm_cdn_frontdoor_security_policy", "cfdsp", 1, 260, false, "[^0-9A-Za-z_-]", "^[0-9A-Za-z_-]{1,260}$", true, "parent", false, OfficialData{"", "Azure Cdn Frontdoor Security Policy", ""}, nil, false},
+	"azurerm_cdn_profile":                                              {"azurerm_cdn_profile", "cdnprof", 1, 260, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,258}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Cdn Profile", ""}, nil, false},
+	"azurerm_cognitive_account":                                        {"azurerm_cognitive_account", "cog", 2, 64, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,63}$", true, "resourceGroup", false, OfficialData{"", "Azure Cognitive Account", ""}, nil, true},
+	"azurerm_cognitive_deployment":                                     {"azurerm_cognitive_deployment", "cog", 2, 64, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,63}$", true, "resourceGroup", false, OfficialData{"", "Azure Cognitive Deployment", ""}, nil, false},
+	"azurerm_communication_service":                                    {"azurerm_communication_service", "acs", 1, 64, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,64}$", true, "parent", false, OfficialData{"", "Azure Communication Service", ""}, nil, false},
+	"azurerm_consumption_budget_resource_group":                        {"azurerm_consumption_budget_resource_group", "acbrg", 1, 63, false, "[^a-zA-Z0-9-_]", "^[a-zA-Z0-9-_]{1,63}$", true, "resourceGroup", false, OfficialData{"", "Azure Consumption Budget Resource Group", ""}, nil, false},
+	"azurerm_consumption_budget_subscription":                          {"azurerm_consumption_budget_subscription", "acbs", 1, 63, false, "[^a-zA-Z0-9-_]", "^[a-zA-Z0-9-_]{1,63}$", true, "subscription", false, OfficialData{"", "Azure Consumption Budget Subscription", ""}, nil, false},
+	"azurerm_containerGroups":                                          {"azurerm_containerGroups", "cg", 1, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,61}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Containergroups", ""}, nil, false},
+	"azurerm_container_app":                                            {"azurerm_container_app", "ca", 1, 32, true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{0,30}[a-z0-9]$", true, "resourceGroup", false, OfficialData{"ca", "Container apps", "Microsoft.App/containerApps"}, nil, false},
+	"azurerm_container_app_environment":                                {"azurerm_container_app_environment", "cae", 1, 60, false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,58}[0-9a-zA-Z]$", true, "resourceGroup", false, OfficialData{"cae", "Container apps environment", "Microsoft.App/managedEnvironments"}, nil, false},
+	"azurerm_container_group":                                          {"azurerm_container_group", "aci", 1, 63, true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{0,61}[a-z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Container Group", ""}, nil, false},
+	"azurerm_container_registry":                                       {"azurerm_container_registry", "cr", 1, 63, true, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{1,63}$", false, "resourceGroup", false, OfficialData{"", "Azure Container Registry", ""}, nil, false},
+	"azurerm_container_registry_webhook":                               {"azurerm_container_registry_webhook", "crwh", 1, 50, false, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{1,50}$", false, "resourceGroup", false, OfficialData{"", "Azure Container Registry Webhook", ""}, nil, false},
+	"azurerm_cosmosdb_account":                                         {"azurerm_cosmosdb_account", "cosmos", 1, 44, true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{0,42}[a-z0-9]$", true, "resourceGroup", false, OfficialData{"cosmos", "Azure Cosmos DB database", "Microsoft.DocumentDB/databaseAccounts"}, nil, false},
+	"azurerm_cosmosdb_cassandra_keyspace":                              {"azurerm_cosmosdb_cassandra_keyspace", "coscas", 1, 255, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,253}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Cosmosdb Cassandra Keyspace", ""}, nil, false},
+	"azurerm_cosmosdb_gremlin_database":                                {"azurerm_cosmosdb_gremlin_database", "cosgrm", 1, 255, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,253}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Cosmosdb Gremlin Database", ""}, nil, false},
+	"azurerm_cosmosdb_gremlin_graph":                                   {"azurerm_cosmosdb_gremlin_graph", "cosgrmg", 1, 255, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,253}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Cosmosdb Gremlin Graph", ""}, nil, false},
+	"azurerm_cosmosdb_mongo_collection":                                {"azurerm_cosmosdb_mongo_collection", "cosmonc", 1, 255, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,253}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Cosmosdb Mongo Collection", ""}, nil, false},
+	"azurerm_cosmosdb_mongo_database":                                  {"azurerm_cosmosdb_mongo_database", "cosmondb", 1, 255, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,253}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Cosmosdb Mongo Database", ""}, nil, false},
+	"azurerm_cosmosdb_sql_container":                                   {"azurerm_cosmosdb_sql_container", "cosqlc", 1, 255, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,253}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Cosmosdb Sql Container", ""}, nil, false},
+	"azurerm_cosmosdb_sql_database":                                    {"azurerm_cosmosdb_sql_database", "cosqldb", 1, 255, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,253}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Cosmosdb Sql Database", ""}, nil, false},
+	"azurerm_cosmosdb_sql_stored_procedure":                            {"azurerm_cosmosdb_sql_stored_procedure", "cosqlsp", 1, 255, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,253}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Cosmosdb Sql Stored Procedure", ""}, nil, false},
+	"azurerm_cosmosdb_table":                                           {"azurerm_cosmosdb_table", "costbl", 1, 255, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,253}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Cosmosdb Table", ""}, nil, false},
+	"azurerm_custom_provider":                                          {"azurerm_custom_provider", "prov", 3, 64, false, "[&%.?\\/]", "^[^&%?\\/]{2,63}[^&%.?\\/ ]$", true, "resourceGroup", false, OfficialData{"", "Azure Custom Provider", ""}, nil, false},
+	"azurerm_dashboard":                                                {"azurerm_dashboard", "dsb", 3, 160, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{3,160}$", true, "parent", false, OfficialData{"", "Azure Dashboard", ""}, nil, false},
+	"azurerm_data_factory":                                             {"azurerm_data_factory", "adf", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "global", false, OfficialData{"adf", "Azure Data Factory", "Microsoft.DataFactory/factories"}, nil, false},
+	"azurerm_data_factory_dataset_azure_blob":                          {"azurerm_data_factory_dataset_azure_blob", "adfblob", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Data Factory Dataset Azure Blob", ""}, nil, false},
+	"azurerm_data_factory_dataset_cosmosdb_sqlapi":                     {"azurerm_data_factory_dataset_cosmosdb_sqlapi", "adfsqlapi", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Data Factory Dataset Cosmosdb Sqlapi", ""}, nil, false},
+	"azurerm_data_factory_dataset_delimited_text":                      {"azurerm_data_factory_dataset_delimited_text", "adfdtext", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Data Factory Dataset Delimited Text", ""}, nil, false},
+	"azurerm_data_factory_dataset_http":                                {"azurerm_data_factory_dataset_http", "adfhttp", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Data Factory Dataset Http", ""}, nil, false},
+	"azurerm_data_factory_dataset_json":                                {"azurerm_data_factory_dataset_json", "adfjson", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Data Factory Dataset Json", ""}, nil, false},
+	"azurerm_data_factory_dataset_mysql":                               {"azurerm_data_factory_dataset_mysql", "adfmysql", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Data Factory Dataset Mysql", ""}, nil, true},
+	"azurerm_data_factory_dataset_postgresql":                          {"azurerm_data_factory_dataset_postgresql", "adfpsql", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Data Factory Dataset Postgresql", ""}, nil, false},
+	"azurerm_data_factory_dataset_sql_server_table":                    {"azurerm_data_factory_dataset_sql_server_table", "adfmssql", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Data Factory Dataset Sql Server Table", ""}, nil, false},
+	"azurerm_data_factory_integration_runtime_managed":                 {"azurerm_data_factory_integration_runtime_managed", "adfir", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Data Factory Integration Runtime Managed", ""}, nil, false},
+	"azurerm_data_factory_integration_runtime_self_hosted":             {"azurerm_data_factory_integration_runtime_self_hosted", "adfirsh", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Data Factory Integration Runtime Self Hosted", ""}, nil, false},
+	"azurerm_data_factory_linked_service_azure_blob_storage":           {"azurerm_data_factory_linked_service_azure_blob_storage", "adflsabs", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent", false, OfficialData{"", "Azure Data Factory Linked Service Azure Blob Storage", ""}, nil, false},
+	"azurerm_data_factory_linked_service_azure_databricks":             {"azurerm_data_factory_linked_service_azure_databricks", "adflsadb", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent", false, OfficialData{"", "Azure Data Factory Linked Service Azure Databricks", ""}, nil, false},
+	"azurerm_data_factory_linked_service_azure_function":               {"azurerm_data_factory_linked_service_azure_function", "adflsaf", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent", false, OfficialData{"", "Azure Data Factory Linked Service Azure Function", ""}, nil, false},
+	"azurerm_data_factory_linked_service_azure_sql_database":           {"azurerm_data_factory_linked_service_azure_sql_database", "adflsasdb", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent", false, OfficialData{"", "Azure Data Factory Linked Service Azure Sql Database", ""}, nil, false},
+	"azurerm_data_factory_linked_service_cosmosdb":                     {"azurerm_data_factory_linked_service_cosmosdb", "adflsacdb", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent", false, OfficialData{"", "Azure Data Factory Linked Service Cosmosdb", ""}, nil, false},
+	"azurerm_data_factory_linked_service_data_lake_storage_gen2":       {"azurerm_data_factory_linked_service_data_lake_storage_gen2", "adfsvst", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent", false, OfficialData{"", "Azure Data Factory Linked Service Data Lake Storage Gen2", ""}, nil, false},
+	"azurerm_data_factory_linked_service_key_vault":                    {"azurerm_data_factory_linked_service_key_vault", "adfsvkv", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent", false, OfficialData{"", "Azure Data Factory Linked Service Key Vault", ""}, nil, false},
+	"azurerm_data_factory_linked_service_mysql":                        {"azurerm_data_factory_linked_service_mysql", "adfsvmysql", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent", false, OfficialData{"", "Azure Data Factory Linked Service Mysql", ""}, nil, false},
+	"azurerm_data_factory_linked_service_postgresql":                   {"azurerm_data_factory_linked_service_postgresql", "adfsvpsql", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent", false, OfficialData{"", "Azure Data Factory Linked Service Postgresql", ""}, nil, false},
+	"azurerm_data_factory_linked_service_sftp":                         {"azurerm_data_factory_linked_service_sftp", "adflsaftp", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent", false, OfficialData{"", "Azure Data Factory Linked Service Sftp", ""}, nil, false},
+	"azurerm_data_factory_linked_service_sql_server":                   {"azurerm_data_factory_linked_service_sql_server", "adfsvmssql", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent", false, OfficialData{"", "Azure Data Factory Linked Service Sql Server", ""}, nil, false},
+	"azurerm_data_factory_linked_service_web":                          {"azurerm_data_factory_linked_service_web", "adfsvweb", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent", false, OfficialData{"", "Azure Data Factory Linked Service Web", ""}, nil, false},
+	"azurerm_data_factory_pipeline":                                    {"azurerm_data_factory_pipeline", "adfpl", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,258}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Data Factory Pipeline", ""}, nil, false},
+	"azurerm_data_factory_trigger_schedule":                            {"azurerm_data_factory_trigger_schedule", "adftg", 1, 260, false, "[^0-9A-Za-z<>*%:.?\\+\\/]", "^[a-zA-Z0-9][^<>*%:.?\\+\\/]{0,259}$", true, "parent", false, OfficialData{"", "Azure Data Factory Trigger Schedule", ""}, nil, false},
+	"azurerm_data_lake_analytics_account":                              {"azurerm_data_lake_analytics_account", "dla", 3, 24, false, "[^0-9a-z]", "^[a-z0-9]{3,24}$", false, "global", false, OfficialData{"", "Azure Data Lake Analytics Account", ""}, nil, false},
+	"azurerm_data_lake_analytics_firewall_rule":                        {"azurerm_data_lake_analytics_firewall_rule", "dlfw", 3, 50, false, "[^0-9a-z_-]", "^[a-z0-9-_]{3,50}$", true, "parent", false, OfficialData{"", "Azure Data Lake Analytics Firewall Rule", ""}, nil, false},
+	"azurerm_data_lake_store":                                          {"azurerm_data_lake_store", "dls", 3, 24, false, "[^0-9a-z]", "^[a-z0-9]{3,24}$", false, "parent", false, OfficialData{"dls", "Data Lake Storage", "Microsoft.DataLakeStore/accounts"}, nil, false},
+	"azurerm_data_lake_store_firewall_rule":                            {"azurerm_data_lake_store_firewall_rule", "dlsfw", 3, 50, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,50}$", true, "parent", false, OfficialData{"", "Azure Data Lake Store Firewall Rule", ""}, nil, false},
+	"azurerm_data_protection_backup_policy_blob_storage":               {"azurerm_data_protection_backup_policy_blob_storage", "dpbpb", 3, 150, false, `[^a-zA-Z0-9-]`, "^[a-zA-Z][a-zA-Z0-9\\-]{1,148}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Data Protection Backup Policy Blob Storage", ""}, nil, false},
+	"azurerm_data_protection_backup_policy_disk":                       {"azurerm_data_protection_backup_policy_disk", "dpbpd", 3, 150, false, `[^a-zA-Z0-9-]`, "^[a-zA-Z][a-zA-Z0-9\\-]{1,148}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Data Protection Backup Policy Disk", ""}, nil, false},
+	"azurerm_data_protection_backup_policy_postgresql":                 {"azurerm_data_protection_backup_policy_postgresql", "dpbpp", 3, 150, false, `[^a-zA-Z0-9-]`, "^[a-zA-Z][a-zA-Z0-9\\-]{1,148}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Data Protection Backup Policy Postgresql", ""}, nil, false},
+	"azurerm_data_protection_backup_policy_postgresql_flexible_server": {"azurerm_data_protection_backup_policy_postgresql_flexible_server", "dpbppf", 3, 150, false, `[^a-zA-Z0-9-]`, "^[a-zA-Z][a-zA-Z0-9\\-]{1,148}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Data Protection Backup Policy Postgresql Flexible Server", ""}, nil, false},
+	"azurerm_data_protection_backup_vault":                             {"azurerm_data_protection_backup_vault", "dpbv", 2, 50, false, `[^a-zA-Z0-9-]`, "^[a-zA-Z][a-zA-Z0-9-]{1,49}$", true, "resourceGroup", false, OfficialData{"", "Azure Data Protection Backup Vault", ""}, nil, false},
+	"azurerm_data_share":                                               {"azurerm_data_share", "dshr", 2, 90, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,89}$", true, "parent", false, OfficialData{"", "Azure Data Share", ""}, nil, false},
+	"azurerm_data_share_account":                                       {"azurerm_data_share_account", "dshra", 2, 90, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,89}$", true, "resourceGroup", false, OfficialData{"", "Azure Data Share Account", ""}, nil, false},
+	"azurerm_data_share_dataset_blob_storage":                          {"azurerm_data_share_dataset_blob_storage", "dshrdsb", 2, 90, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,89}$", true, "parent", false, OfficialData{"", "Azure Data Share Dataset Blob Storage", ""}, nil, false},
+	"azurerm_data_share_dataset_data_lake_gen1":                        {"azurerm_data_share_dataset_data_lake_gen1", "dshrdsg1", 2, 90, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,89}$", true, "parent", false, OfficialData{"", "Azure Data Share Dataset Data Lake Gen1", ""}, nil, false},
+	"azurerm_data_share_dataset_data_lake_gen2":                        {"azurerm_data_share_dataset_data_lake_gen2", "dshrdsg2", 2, 90, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,89}$", true, "parent", false, OfficialData{"", "Azure Data Share Dataset Data Lake Gen2", ""}, nil, false},
+	"azurerm_data_share_dataset_kusto_cluster":                         {"azurerm_data_share_dataset_kusto_cluster", "dshrdskc", 2, 90, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,89}$", true, "parent", false, OfficialData{"", "Azure Data Share Dataset Kusto Cluster", ""}, nil, false},
+	"azurerm_data_share_dataset_kusto_database":                        {"azurerm_data_share_dataset_kusto_database", "dshrdskd", 2, 90, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,89}$", true, "parent", false, OfficialData{"", "Azure Data Share Dataset Kusto Database", ""}, nil, false},
+	"azurerm_database_migration_project":                               {"azurerm_database_migration_project", "migr", 2, 57, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,56}$", true, "parent", false, OfficialData{"", "Azure Database Migration Project", ""}, nil, false},
+	"azurerm_database_migration_service":                               {"azurerm_database_migration_service", "dms", 2, 62, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{1,61}$", true, "resourceGroup", false, OfficialData{"dms", "Database Migration Service", "Microsoft.DataMigration/services"}, nil, false},
+	"azurerm_databricks_workspace":                                     {"azurerm_databricks_workspace", "dbw", 3, 64, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,64}$", true, "resourceGroup", false, OfficialData{"", "Azure Databricks Workspace", ""}, nil, false},
+	"azurerm_dedicated_hardware_security_module":                       {"azurerm_dedicated_hardware_security_module", "hsm", 3, 24, false, "[^0-9A-Za-z-]", "^[a-zA-Z][a-zA-Z0-9-]{1,22}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Dedicated Hardware Security Module", ""}, nil, false},
+	"azurerm_dedicated_host":                                           {"azurerm_dedicated_host", "dh", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Dedicated Host", ""}, nil, false},
+	"azurerm_dedicated_host_group":                                     {"azurerm_dedicated_host_group", "dhg", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Dedicated Host Group", ""}, nil, false},
+	"azurerm_dev_center":                                               {"azurerm_dev_center", "dc", 3, 26, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,24}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Dev Center", ""}, nil, false},
+	"azurerm_dev_center_catalog":                                       {"azurerm_dev_center_catalog", "dcc", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Dev Center Catalog", ""}, nil, false},
+	"azurerm_dev_center_dev_box_definition":                            {"azurerm_dev_center_dev_box_definition", "dcdb", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Dev Center Dev Box Definition", ""}, nil, false},
+	"azurerm_dev_center_environment_type":                              {"azurerm_dev_center_environment_type", "dcet", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Dev Center Environment Type", ""}, nil, false},
+	"azurerm_dev_center_gallery":                                       {"azurerm_dev_center_gallery", "dcg", 1, 80, false, "[^0-9A-Za-z_.]", "^[a-zA-Z0-9]([a-zA-Z0-9_.]{0,78}[a-zA-Z0-9])?$", false, "parent", false, OfficialData{"", "Azure Dev Center Gallery", ""}, nil, false},
+	"azurerm_dev_center_network_connection":                            {"azurerm_dev_center_network_connection", "dcnc", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Dev Center Network Connection", ""}, nil, false},
+	"azurerm_dev_center_project":                                       {"azurerm_dev_center_project", "dcp", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Dev Center Project", ""}, nil, false},
+	"azurerm_dev_center_project_environment_type":                      {"azurerm_dev_center_project_environment_type", "dcpet", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Dev Center Project Environment Type", ""}, nil, false},
+	"azurerm_dev_test_lab":                                             {"azurerm_dev_test_lab", "lab", 1, 50, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,50}$", true, "resourceGroup", false, OfficialData{"", "Azure Dev Test Lab", ""}, nil, false},
+	"azurerm_dev_test_linux_virtual_machine":                           {"azurerm_dev_test_linux_virtual_machine", "labvm", 1, 64, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{1,64}$", true, "parent", false, OfficialData{"", "Azure Dev Test Linux Virtual Machine", ""}, nil, false},
+	"azurerm_dev_test_schedule":                                        {"azurerm_dev_test_schedule", "dtls", 1, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,62}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Dev Test Schedule", ""}, nil, false},
+	"azurerm_dev_test_virtual_network":                                 {"azurerm_dev_test_virtual_network", "dtlvn", 1, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,62}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Dev Test Virtual Network", ""}, nil, false},
+	"azurerm_dev_test_windows_virtual_machine":                         {"azurerm_dev_test_windows_virtual_machine", "labvm", 1, 15, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{1,15}$", true, "parent", false, OfficialData{"", "Azure Dev Test Windows Virtual Machine", ""}, nil, false},
+	"azurerm_devspace_controller":                                      {"azurerm_devspace_controller", "dsc", 1, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,61}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Devspace Controller", ""}, nil, false},
+	"azurerm_digital_twins_endpoint_eventgrid":                         {"azurerm_digital_twins_endpoint_eventgrid", "adteg", 3, 50, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,50}$", true, "parent", false, OfficialData{"", "Azure Digital Twins Endpoint Eventgrid", ""}, nil, false},
+	"azurerm_digital_twins_endpoint_eventhub":                          {"azurerm_digital_twins_endpoint_eventhub", "adteh", 3, 50, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,50}$", true, "parent", false, OfficialData{"", "Azure Digital Twins Endpoint Eventhub", ""}, nil, false},
+	"azurerm_digital_twins_endpoint_servicebus":                        {"azurerm_digital_twins_endpoint_servicebus", "adtsb", 3, 50, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,50}$", true, "parent", false, OfficialData{"", "Azure Digital Twins Endpoint Servicebus", ""}, nil, false},
+	"azurerm_digital_twins_instance":                                   {"azurerm_digital_twins_instance", "adt", 4, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,63}$", true, "subscription", false, OfficialData{"adt", "Azure Digital Twins", "Microsoft.DigitalTwins/digitalTwinsInstances"}, nil, true},
+	"azurerm_disk_encryption_set":                                      {"azurerm_disk_encryption_set", "des", 1, 80, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,80}$", true, "resourceGroup", false, OfficialData{"", "Azure Disk Encryption Set", ""}, nil, false},
+	"azurerm_dns_a_record":                                             {"azurerm_dns_a_record", "dnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", true, OfficialData{"", "Azure Dns A Record", ""}, nil, false},
+	"azurerm_dns_aaaa_record":                                          {"azurerm_dns_aaaa_record", "dnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", true, OfficialData{"", "Azure Dns Aaaa Record", ""}, nil, false},
+	"azurerm_dns_caa_record":                                           {"azurerm_dns_caa_record", "dnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", true, OfficialData{"", "Azure Dns Caa Record", ""}, nil, false},
+	"azurerm_dns_cname_record":                                         {"azurerm_dns_cname_record", "dnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", true, OfficialData{"", "Azure Dns Cname Record", ""}, nil, false},
+	"azurerm_dns_mx_record":                                            {"azurerm_dns_mx_record", "dnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", true, OfficialData{"", "Azure Dns Mx Record", ""}, nil, false},
+	"azurerm_dns_ns_record":                                            {"azurerm_dns_ns_record", "dnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", true, OfficialData{"", "Azure Dns Ns Record", ""}, nil, false},
+	"azurerm_dns_ptr_record":                                           {"azurerm_dns_ptr_record", "dnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", true, OfficialData{"", "Azure Dns Ptr Record", ""}, nil, false},
+	"azurerm_dns_txt_record":                                           {"azurerm_dns_txt_record", "dnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", true, OfficialData{"", "Azure Dns Txt Record", ""}, nil, false},
+	"azurerm_dns_zone":                                                 {"azurerm_dns_zone", "dns", 1, 63, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,61}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"dns", "Private DNS zone", "Microsoft.Network/privateDnsZones"}, nil, false},
+	"azurerm_email_communication_service":                              {"azurerm_email_communication_service", "acsmail", 1, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,61}[a-zA-Z0-9]$", true, "global", true, OfficialData{"", "Azure Email Communication Service", ""}, nil, false},
+	"azurerm_eventgrid_domain":                                         {"azurerm_eventgrid_domain", "egd", 3, 50, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{3,50}$", true, "resourceGroup", false, OfficialData{"", "Azure Eventgrid Domain", ""}, nil, false},
+	"azurerm_eventgrid_domain_topic":                                   {"azurerm_eventgrid_domain_topic", "egdt", 3, 50, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{3,50}$", true, "parent", false, OfficialData{"", "Azure Eventgrid Domain Topic", ""}, nil, false},
+	"azurerm_eventgrid_event_subscription":                             {"azurerm_eventgrid_event_subscription", "egs", 3, 64, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{3,64}$", true, "resourceGroup", false, OfficialData{"", "Azure Eventgrid Event Subscription", ""}, nil, false},
+	"azurerm_eventgrid_topic":                                          {"azurerm_eventgrid_topic", "egt", 3, 50, false, `[^0-9A-Za-z-]`, "^[a-zA-Z0-9-]{3,50}$", true, "resourceGroup", false, OfficialData{"", "Azure Eventgrid Topic", ""}, nil, false},
+	"azurerm_eventhub":                                                 {"azurerm_eventhub", "evh", 1, 50, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Eventhub", ""}, nil, false},
+	"azurerm_eventhub_authorization_rule":                              {"azurerm_eventhub_authorization_rule", "ehar", 1, 50, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Eventhub Authorization Rule", ""}, nil, false},
+	"azurerm_eventhub_cluster":                                         {"azurerm_eventhub_cluster", "ehc", 1, 50, false, "[^0-9A-Za-z-]", "^[a-zA-Z][a-zA-Z0-9-]{0,48}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Eventhub Cluster", ""}, nil, false},
+	"azurerm_eventhub_consumer_group":                                  {"azurerm_eventhub_consumer_group", "ehcg", 1, 50, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Eventhub Consumer Group", ""}, nil, false},
+	"azurerm_eventhub_namespace":                                       {"azurerm_eventhub_namespace", "ehn", 1, 50, false, "[^0-9A-Za-z-]", "^[a-zA-Z][a-zA-Z0-9-]{0,48}[a-zA-Z0-9]$", true, "global", false, OfficialData{"ehn", "Event hub", "Microsoft.EventHub/namespaces/eventhubs"}, nil, false},
+	"azurerm_eventhub_namespace_authorization_rule":                    {"azurerm_eventhub_namespace_authorization_rule", "ehnar", 1, 50, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Eventhub Namespace Authorization Rule", ""}, nil, false},
+	"azurerm_eventhub_namespace_disaster_recovery_config":              {"azurerm_eventhub_namespace_disaster_recovery_config", "ehdr", 1, 50, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Eventhub Namespace Disaster Recovery Config", ""}, nil, false},
+	"azurerm_express_route_circuit":                                    {"azurerm_express_route_circuit", "erc", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Express Route Circuit", ""}, nil, false},
+	"azurerm_express_route_circuit_authorization":                      {"azurerm_express_route_circuit_authorization", "erca", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Express Route Circuit Authorization", ""}, nil, false},
+	"azurerm_express_route_gateway":                                    {"azurerm_express_route_gateway", "ergw", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Express Route Gateway", ""}, nil, false},
+	"azurerm_federated_identity_credential":                            {"azurerm_federated_identity_credential", "fedcred", 3, 120, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9][a-zA-Z0-9_-]{2,119}$", true, "parent", false, OfficialData{"", "Azure Federated Identity Credential", ""}, nil, false},
+	"azurerm_firewall":                                                 {"azurerm_firewall", "fw", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"fw", "Azure Firewall", "Microsoft.Network/azureFirewalls"}, nil, false},
+	"azurerm_firewall_application_rule_collection":                     {"azurerm_firewall_application_rule_collection", "fwapp", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", true, OfficialData{"", "Azure Firewall Application Rule Collection", ""}, nil, false},
+	"azurerm_firewall_ip_configuration":                                {"azurerm_firewall_ip_configuration", "fwipconf", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", true, OfficialData{"", "Azure Firewall Ip Configuration", ""}, nil, false},
+	"azurerm_firewall_nat_rule_collection":                             {"azurerm_firewall_nat_rule_collection", "fwnatrc", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", true, OfficialData{"", "Azure Firewall Nat Rule Collection", ""}, nil, false},
+	"azurerm_firewall_network_rule_collection":                         {"azurerm_firewall_network_rule_collection", "fwnetrc", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", true, OfficialData{"", "Azure Firewall Network Rule Collection", ""}, nil, false},
+	"azurerm_firewall_policy":                                          {"azurerm_firewall_policy", "afwp", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Firewall Policy", ""}, nil, false},
+	"azurerm_firewall_policy_rule_collection_group":                    {"azurerm_firewall_policy_rule_collection_group", "fwprcg", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Firewall Policy Rule Collection Group", ""}, nil, false},
+	"azurerm_frontdoor":                                                {"azurerm_frontdoor", "fd", 5, 64, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{3,62}[a-zA-Z0-9]$", true, "global", false, OfficialData{"fd", "Front Door", "Microsoft.Network/frontDoors"}, nil, false},
+	"azurerm_frontdoor_firewall_policy":                                {"azurerm_frontdoor_firewall_policy", "fdfw", 1, 80, false, "[^0-9A-Za-z]", "^[a-zA-Z0-9][a-zA-Z0-9]{0,78}[a-zA-Z0-9]$", false, "global", false, OfficialData{"", "Azure Frontdoor Firewall Policy", ""}, nil, false},
+	"azurerm_function_app":                                             {"azurerm_function_app", "fa", 2, 60, false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,58}[0-9a-zA-Z]$", true, "global", false, OfficialData{"", "Azure Function App", ""}, nil, true},
+	"azurerm_function_app_slot":                                        {"azurerm_function_app_slot", "fas", 2, 59, false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,57}[0-9a-zA-Z]$", true, "global", false, OfficialData{"", "Azure Function App Slot", ""}, nil, true},
+	"azurerm_hdinsight_hadoop_cluster":                                 {"azurerm_hdinsight_hadoop_cluster", "hadoop", 3, 59, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global", false, OfficialData{"", "Azure Hdinsight Hadoop Cluster", ""}, nil, false},
+	"azurerm_hdinsight_hbase_cluster":                                  {"azurerm_hdinsight_hbase_cluster", "hbase", 3, 59, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global", false, OfficialData{"", "Azure Hdinsight Hbase Cluster", ""}, nil, false},
+	"azurerm_hdinsight_interactive_query_cluster":                      {"azurerm_hdinsight_interactive_query_cluster", "iqr", 3, 59, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global", false, OfficialData{"", "Azure Hdinsight Interactive Query Cluster", ""}, nil, false},
+	"azurerm_hdinsight_kafka_cluster":                                  {"azurerm_hdinsight_kafka_cluster", "kafka", 3, 59, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global", false, OfficialData{"", "Azure Hdinsight Kafka Cluster", ""}, nil, false},
+	"azurerm_hdinsight_ml_services_cluster":                            {"azurerm_hdinsight_ml_services_cluster", "mls", 3, 59, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global", false, OfficialData{"", "Azure Hdinsight Ml Services Cluster", ""}, nil, false},
+	"azurerm_hdinsight_rserver_cluster":                                {"azurerm_hdinsight_rserver_cluster", "rser", 3, 59, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global", false, OfficialData{"", "Azure Hdinsight Rserver Cluster", ""}, nil, false},
+	"azurerm_hdinsight_spark_cluster":                                  {"azurerm_hdinsight_spark_cluster", "spark", 3, 59, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global", false, OfficialData{"", "Azure Hdinsight Spark Cluster", ""}, nil, false},
+	"azurerm_hdinsight_storm_cluster":                                  {"azurerm_hdinsight_storm_cluster", "storm", 3, 59, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,57}[a-zA-Z0-9]$", true, "global", false, OfficialData{"", "Azure Hdinsight Storm Cluster", ""}, nil, false},
+	"azurerm_healthcare_dicom_service":                                 {"azurerm_healthcare_dicom_service", "dicom", 3, 24, true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,22}[a-z0-9]$", true, "parent", false, OfficialData{"", "Azure Healthcare Dicom Service", ""}, nil, false},
+	"azurerm_healthcare_fhir_service":                                  {"azurerm_healthcare_fhir_service", "fhir", 3, 24, true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,22}[a-z0-9]$", true, "parent", false, OfficialData{"", "Azure Healthcare Fhir Service", ""}, nil, false},
+	"azurerm_healthcare_medtech_service":                               {"azurerm_healthcare_medtech_service", "medtech", 3, 24, true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,22}[a-z0-9]$", true, "parent", false, OfficialData{"", "Azure Healthcare Medtech Service", ""}, nil, false},
+	"azurerm_healthcare_service":                                       {"azurerm_healthcare_service", "hcasvc", 3, 24, true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,22}[a-z0-9]$", true, "global", false, OfficialData{"", "Azure Healthcare Service", ""}, nil, false},
+	"azurerm_healthcare_workspace":                                     {"azurerm_healthcare_workspace", "hcw", 3, 24, true, "[^0-9a-z]", "^[a-z0-9]{3,24}$", false, "global", false, OfficialData{"", "Azure Healthcare Workspace", ""}, nil, false},
+	"azurerm_hpc_cache":                                                {"azurerm_hpc_cache", "hpcc", 2, 31, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,29}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Hpc Cache", ""}, nil, false},
+	"azurerm_hpc_cache_blob_target":                                    {"azurerm_hpc_cache_blob_target", "hpcbt", 2, 31, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,29}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Hpc Cache Blob Target", ""}, nil, false},
+	"azurerm_hpc_cache_nfs_target":                                     {"azurerm_hpc_cache_nfs_target", "hpcnt", 2, 31, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,29}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Hpc Cache Nfs Target", ""}, nil, false},
+	"azurerm_image":                                                    {"azurerm_image", "img", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Image", ""}, nil, false},
+	"azurerm_integration_service_environment":                          {"azurerm_integration_service_environment", "lappise", 1, 80, false, "[^0-9A-Za-z\\-\\_\\.]", "^[0-9A-Za-z\\-\\_\\.]{1,80}$", true, "resourceGroup", false, OfficialData{"", "Azure Integration Service Environment", ""}, nil, false},
+	"azurerm_iot_security_device_group":                                {"azurerm_iot_security_device_group", "iotdg", 1, 32, false, "[^0-9A-Za-z-._]", "^[a-zA-Z0-9-._]{1,32}$", true, "parent", false, OfficialData{"", "Azure Iot Security Device Group", ""}, nil, false},
+	"azurerm_iot_security_solution":                                    {"azurerm_iot_security_solution", "iotss", 1, 260, false, "[^0-9A-Za-z-_]", "^[a-zA-Z0-9-_]{1,260}$", true, "resourceGroup", false, OfficialData{"", "Azure Iot Security Solution", ""}, nil, false},
+	"azurerm_iot_time_series_insights_reference_data_set":              {"azurerm_iot_time_series_insights_reference_data_set", "tsirds", 3, 63, false, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{3,63}$", false, "parent", false, OfficialData{"", "Azure Iot Time Series Insights Reference Data Set", ""}, nil, false},
+	"azurerm_iot_time_series_insights_standard_environment":            {"azurerm_iot_time_series_insights_standard_environment", "tsise", 1, 90, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,88}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Iot Time Series Insights Standard Environment", ""}, nil, false},
+	"azurerm_iotcentral_application":                                   {"azurerm_iotcentral_application", "iotapp", 2, 63, true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{0,61}[a-z0-9]$", true, "global", false, OfficialData{"", "Azure Iotcentral Application", ""}, nil, false},
+	"azurerm_iothub":                                                   {"azurerm_iothub", "iot", 3, 50, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,48}[a-z0-9]$", true, "global", false, OfficialData{"iot", "IoT Hub", "Microsoft.Devices/IotHubs"}, nil, false},
+	"azurerm_iothub_certificate":                                       {"azurerm_iothub_certificate", "iotcert", 1, 64, false, "[^0-9A-Za-z-._]", "^[a-zA-Z0-9-._]{1,64}$", true, "parent", false, OfficialData{"", "Azure Iothub Certificate", ""}, nil, false},
+	"azurerm_iothub_consumer_group":                                    {"azurerm_iothub_consumer_group", "iotcg", 1, 50, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9-._]{1,50}$", true, "parent", false, OfficialData{"", "Azure Iothub Consumer Group", ""}, nil, false},
+	"azurerm_iothub_dps":                                               {"azurerm_iothub_dps", "dps", 3, 64, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{1,63}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"dps", "Device Provisioning Service", "Microsoft.Devices/provisioningServices"}, nil, false},
+	"azurerm_iothub_dps_certificate":                                   {"azurerm_iothub_dps_certificate", "dpscert", 1, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9-._]{1,64}$", true, "parent", false, OfficialData{"", "Azure Iothub Dps Certificate", ""}, nil, false},
+	"azurerm_iothub_dps_shared_access_policy":                          {"azurerm_iothub_dps_shared_access_policy", "dpssap", 1, 64, false, "[^0-9A-Za-z-._]", "^[a-zA-Z0-9-._]{1,64}$", true, "parent", false, OfficialData{"", "Azure Iothub Dps Shared Access Policy", ""}, nil, false},
+	"azurerm_iothub_endpoint_eventhub":                                 {"azurerm_iothub_endpoint_eventhub", "iothepeh", 1, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,62}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Iothub Endpoint Eventhub", ""}, nil, false},
+	"azurerm_iothub_endpoint_servicebus_queue":                         {"azurerm_iothub_endpoint_servicebus_queue", "iothepsbq", 1, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,62}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Iothub Endpoint Servicebus Queue", ""}, nil, false},
+	"azurerm_iothub_endpoint_servicebus_topic":                         {"azurerm_iothub_endpoint_servicebus_topic", "iothepsbt", 1, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,62}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Iothub Endpoint Servicebus Topic", ""}, nil, false},
+	"azurerm_iothub_endpoint_storage_container":                        {"azurerm_iothub_endpoint_storage_container", "iothepsc", 1, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,62}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Iothub Endpoint Storage Container", ""}, nil, false},
+	"azurerm_iothub_route":                                             {"azurerm_iothub_route", "iothr", 1, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,62}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Iothub Route", ""}, nil, false},
+	"azurerm_iothub_shared_access_policy":                              {"azurerm_iothub_shared_access_policy", "iotsap", 1, 64, false, "[^0-9A-Za-z-._]", "^[a-zA-Z0-9-._]{1,64}$", true, "parent", false, OfficialData{"", "Azure Iothub Shared Access Policy", ""}, nil, false},
+	"azurerm_ip_group":                                                 {"azurerm_ip_group", "ipgr", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Ip Group", ""}, nil, false},
+	"azurerm_key_vault":                                                {"azurerm_key_vault", "kv", 3, 24, false, "[^0-9A-Za-z-]", "^[a-zA-Z][a-zA-Z0-9-]{1,22}[a-zA-Z0-9]$", true, "global", false, OfficialData{"kv", "Key Vault", "Microsoft.KeyVault/vaults"}, nil, false},
+	"azurerm_key_vault_certificate":                                    {"azurerm_key_vault_certificate", "kvc", 1, 127, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{1,127}$", true, "parent", false, OfficialData{"", "Azure Key Vault Certificate", ""}, nil, false},
+	"azurerm_key_vault_key":                                            {"azurerm_key_vault_key", "kvk", 1, 127, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{1,127}$", true, "parent", false, OfficialData{"", "Azure Key Vault Key", ""}, nil, false},
+	"azurerm_key_vault_secret":                                         {"azurerm_key_vault_secret", "kvs", 1, 127, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9-]{1,127}$", true, "parent", false, OfficialData{"", "Azure Key Vault Secret", ""}, nil, false},
+	"azurerm_kubernetes_cluster":                                       {"azurerm_kubernetes_cluster", "aks", 1, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9][a-zA-Z0-9-_]{0,61}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"aks", "AKS cluster", "Microsoft.ContainerService/managedClusters"}, nil, false},
+	"azurerm_kubernetes_fleet_manager":                                 {"azurerm_kubernetes_fleet_manager", "fleet", 1, 63, true, "[^0-9a-z-]", "^[0-9a-z]([0-9a-z-]{0,61}[0-9a-z])?$", true, "resourceGroup", false, OfficialData{"", "Azure Kubernetes Fleet Manager", ""}, nil, false},
+	"azurerm_kusto_attached_database_configuration":                    {"azurerm_kusto_attached_database_configuration", "kadc", 1, 260, false, "[^0-9A-Za-z- .]", "^[a-zA-Z0-9- .]{1,260}$", true, "parent", false, OfficialData{"", "Azure Kusto Attached Database Configuration", ""}, nil, false},
+	"azurerm_kusto_cluster":                                            {"azurerm_kusto_cluster", "kc", 4, 22, false, "[^0-9A-Za-z]", "^[a-z][a-z0-9]{3,21}$", false, "global", false, OfficialData{"", "Azure Kusto Cluster", ""}, nil, false},
+	"azurerm_kusto_database":                                           {"azurerm_kusto_database", "kdb", 1, 260, false, "[^0-9A-Za-z- .]", "^[a-zA-Z0-9- .]{1,260}$", true, "parent", false, OfficialData{"", "Azure Kusto Database", ""}, nil, false},
+	"azurerm_kusto_eventhub_data_connection":                           {"azurerm_kusto_eventhub_data_connection", "kehc", 1, 40, false, "[^0-9A-Za-z- .]", "^[a-zA-Z0-9- .]{1,40}$", true, "parent", false, OfficialData{"", "Azure Kusto Eventhub Data Connection", ""}, nil, false},
+	"azurerm_lb":                                                       {"azurerm_lb", "lb", 1, 80, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"lb", "Load balancer", "Microsoft.Network/loadBalancers"}, nil, false},
+	"azurerm_lb_backend_address_pool":                                  {"azurerm_lb_backend_address_pool", "adt", 4, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,63}$", true, "subscription", false, OfficialData{"adt", "Azure Digital Twins", "Microsoft.DigitalTwins/digitalTwinsInstances"}, nil, false},
+	"azurerm_lb_backend_pool":                                          {"azurerm_lb_backend_pool", "adt", 4, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,63}$", true, "subscription", false, OfficialData{"adt", "Azure Digital Twins", "Microsoft.DigitalTwins/digitalTwinsInstances"}, nil, false},
+	"azurerm_lb_nat_pool":                                              {"azurerm_lb_nat_pool", "adt", 4, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,63}$", true, "subscription", false, OfficialData{"adt", "Azure Digital Twins", "Microsoft.DigitalTwins/digitalTwinsInstances"}, nil, false},
+	"azurerm_lb_nat_rule":                                              {"azurerm_lb_nat_rule", "lbnatrl", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Lb Nat Rule", ""}, nil, false},
+	"azurerm_lb_outbound_rule":                                         {"azurerm_lb_outbound_rule", "adt", 4, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,63}$", true, "subscription", false, OfficialData{"adt", "Azure Digital Twins", "Microsoft.DigitalTwins/digitalTwinsInstances"}, nil, false},
+	"azurerm_lb_probe":                                                 {"azurerm_lb_probe", "adt", 4, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,63}$", true, "subscription", false, OfficialData{"adt", "Azure Digital Twins", "Microsoft.DigitalTwins/digitalTwinsInstances"}, nil, false},
+	"azurerm_lb_rule":                                                  {"azurerm_lb_rule", "adt", 4, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9_-]{1,63}$", true, "subscription", false, OfficialData{"adt", "Azure Digital Twins", "Microsoft.DigitalTwins/digitalTwinsInstances"}, nil, false},
+	"azurerm_lighthouse_definition":                                    {"azurerm_lighthouse_definition", "lhd", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Lighthouse Definition", ""}, nil, false},
+	"azurerm_linux_function_app":                                       {"azurerm_linux_function_app", "fa", 2, 60, false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,58}[0-9a-zA-Z]$", true, "global", false, OfficialData{"", "Azure Linux Function App", ""}, nil, false},
+	"azurerm_linux_function_app_slot":                                  {"azurerm_linux_function_app_slot", "fas", 2, 59, false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,57}[0-9a-zA-Z]$", true, "global", false, OfficialData{"", "Azure Linux Function App Slot", ""}, nil, false},
+	"azurerm_linux_virtual_machine":                                    {"azurerm_linux_virtual_machine", "vm", 1, 64, false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,62}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup", false, OfficialData{"vm", "Virtual machine", "Microsoft.Compute/virtualMachines"}, []string{"vml"}, false},
+	"azurerm_linux_virtual_machine_scale_set":                          {"azurerm_linux_virtual_machine_scale_set", "vmss", 1, 64, false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,62}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup", false, OfficialData{"vmss", "Virtual machine scale set", "Microsoft.Compute/virtualMachineScaleSets"}, nil, false},
+	"azurerm_linux_web_app":                                            {"azurerm_linux_web_app", "lwapp", 2, 60, false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,58}[0-9a-zA-Z]$", true, "global", false, OfficialData{"", "Azure Linux Web App", ""}, nil, false},
+	"azurerm_load_test":                                                {"azurerm_load_test", "load", 1, 64, false, "[^0-9A-Za-z-_]", "^[a-zA-Z][a-zA-Z0-9-_]{0,62}[a-zA-Z0-9|]$", true, "global", false, OfficialData{"", "Azure Load Test", ""}, nil, false},
+	"azurerm_local_network_gateway":                                    {"azurerm_local_network_gateway", "lgw", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"lgw", "Local network gateway", "Microsoft.Network/localNetworkGateways"}, nil, false},
+	"azurerm_log_analytics_cluster":                                    {"azurerm_log_analytics_cluster", "logc", 4, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{2,61}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Log Analytics Cluster", ""}, nil, false},
+	"azurerm_log_analytics_data_export_rule":                           {"azurerm_log_analytics_data_export_rule", "laer", 4, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{2,61}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Log Analytics Data Export Rule", ""}, nil, false},
+	"azurerm_log_analytics_query_pack":                                 {"azurerm_log_analytics_query_pack", "laqp", 4, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{2,61}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Log Analytics Query Pack", ""}, nil, false},
+	"azurerm_log_analytics_saved_search":                               {"azurerm_log_analytics_saved_search", "lass", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Log Analytics Saved Search", ""}, nil, false},
+	"azurerm_log_analytics_solution":                                   {"azurerm_log_analytics_solution", "las", 4, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{2,61}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Log Analytics Solution", ""}, nil, false},
+	"azurerm_log_analytics_storage_insights":                           {"azurerm_log_analytics_storage_insights", "lasi", 4, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{2,61}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Log Analytics Storage Insights", ""}, nil, false},
+	"azurerm_log_analytics_workspace":                                  {"azurerm_log_analytics_workspace", "log", 4, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{2,61}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"log", "Log Analytics workspace", "Microsoft.OperationalInsights/workspaces"}, nil, false},
+	"azurerm_logic_app_action_custom":                                  {"azurerm_logic_app_action_custom", "lappac", 1, 80, false, "[^0-9A-Za-z\\(\\-\\)\\_\\.]", "^[0-9A-Za-z\\(\\-\\)\\_\\.]{1,80}$", true, "resourceGroup", false, OfficialData{"", "Azure Logic App Action Custom", ""}, nil, false},
+	"azurerm_logic_app_action_http":                                    {"azurerm_logic_app_action_http", "lappah", 1, 80, false, "[^0-9A-Za-z\\(\\-\\)\\_\\.]", "^[0-9A-Za-z\\(\\-\\)\\_\\.]{1,80}$", true, "resourceGroup", false, OfficialData{"", "Azure Logic App Action Http", ""}, nil, false},
+	"azurerm_logic_app_integration_account":                            {"azurerm_logic_app_integration_account", "lappia", 1, 80, false, "[^0-9A-Za-z\\(\\-\\)\\_\\.]", "^[0-9A-Za-z\\(\\-\\)\\_\\.]{1,80}$", true, "resourceGroup", false, OfficialData{"", "Azure Logic App Integration Account", ""}, nil, false},
+	"azurerm_logic_app_trigger_custom":                                 {"azurerm_logic_app_trigger_custom", "lapptc", 1, 80, false, "[^0-9A-Za-z\\(\\-\\)\\_\\.]", "^[0-9A-Za-z\\(\\-\\)\\_\\.]{1,80}$", true, "resourceGroup", false, OfficialData{"", "Azure Logic App Trigger Custom", ""}, nil, true},
+	"azurerm_logic_app_trigger_http_request":                           {"azurerm_logic_app_trigger_http_request", "lappth", 1, 80, false, "[^0-9A-Za-z\\(\\-\\)\\_\\.]", "^[0-9A-Za-z\\(\\-\\)\\_\\.]{1,80}$", true, "resourceGroup", false, OfficialData{"", "Azure Logic App Trigger Http Request", ""}, nil, false},
+	"azurerm_logic_app_trigger_recurrence":                             {"azurerm_logic_app_trigger_recurrence", "lapptc", 1, 80, false, "[^0-9A-Za-z\\(\\-\\)\\_\\.]", "^[0-9A-Za-z\\(\\-\\)\\_\\.]{1,80}$", true, "resourceGroup", false, OfficialData{"", "Azure Logic App Trigger Recurrence", ""}, nil, false},
+	"azurerm_logic_app_workflow":                                       {"azurerm_logic_app_workflow", "lapp", 1, 80, false, "[^0-9A-Za-z\\(\\-\\)\\_\\.]", "^[0-9A-Za-z\\(\\-\\)\\_\\.]{1,80}$", true, "resourceGroup", false, OfficialData{"", "Azure Logic App Workflow", ""}, nil, false},
+	"azurerm_machine_learning_compute_instance":                        {"azurerm_machine_learning_compute_instance", "amlci", 1, 16, false, "[^0-9a-z-]", "^[a-zA-Z0-9][a-z0-9-]{0,14}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Machine Learning Compute Instance", ""}, nil, false},
+	"azurerm_machine_learning_workspace":                               {"azurerm_machine_learning_workspace", "mlw", 1, 260, false, "[<>*%:.?\\+\\/]", "^[^<>*%:.?\\+\\/]{0,259}[^<>*%:.?\\+\\/ ]$", true, "resourceGroup", false, OfficialData{"", "Azure Machine Learning Workspace", ""}, nil, false},
+	"azurerm_maintenance_configuration":                                {"azurerm_maintenance_configuration", "mcf", 1, 60, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,58}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Maintenance Configuration", ""}, nil, false},
+	"azurerm_managed_application":                                      {"azurerm_managed_application", "manapp", 1, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,62}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Managed Application", ""}, nil, false},
+	"azurerm_managed_application_definition":                           {"azurerm_managed_application_definition", "manappd", 3, 64, false, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{3,64}$", false, "resourceGroup", false, OfficialData{"", "Azure Managed Application Definition", ""}, nil, false},
+	"azurerm_managed_disk":                                             {"azurerm_managed_disk", "dsk", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"dsk", "Managed disk", "Microsoft.Compute/disks"}, nil, false},
+	"azurerm_managed_redis":                                            {"azurerm_managed_redis", "amr", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"amr", "Azure Managed Redis", "Microsoft.Cache/redisEnterprise"}, nil, false},
+	"azurerm_management_group":                                         {"azurerm_management_group", "mg", 1, 90, false, "[^0-9A-Za-z-_.()]", "^[a-zA-Z0-9-_.()]{1,90}$", true, "parent", false, OfficialData{"", "Azure Management Group", ""}, nil, false},
+	"azurerm_management_lock":                                          {"azurerm_management_lock", "mgl", 1, 260, false, "[^0-9A-Za-z-_.]", "^[a-zA-Z0-9-_.]{1,260}$", true, "parent", false, OfficialData{"", "Azure Management Lock", ""}, nil, false},
+	"azurerm_maps_account":                                             {"azurerm_maps_account", "map", 1, 98, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,97}$", true, "resourceGroup", false, OfficialData{"", "Azure Maps Account", ""}, nil, false},
+	"azurerm_mariadb_database":                                         {"azurerm_mariadb_database", "mariadb", 1, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,63}$", true, "parent", false, OfficialData{"", "Azure Mariadb Database", ""}, nil, false},
+	"azurerm_mariadb_firewall_rule":                                    {"azurerm_mariadb_firewall_rule", "mariafw", 1, 128, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent", false, OfficialData{"", "Azure Mariadb Firewall Rule", ""}, nil, false},
+	"azurerm_mariadb_server":                                           {"azurerm_mariadb_server", "maria", 3, 63, false, "[^0-9A-Za-z-]", "^[a-z0-9][a-zA-Z0-9-]{1,61}[a-z0-9]$", true, "global", false, OfficialData{"", "Azure Mariadb Server", ""}, nil, false},
+	"azurerm_mariadb_virtual_network_rule":                             {"azurerm_mariadb_virtual_network_rule", "mariavn", 1, 128, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent", false, OfficialData{"", "Azure Mariadb Virtual Network Rule", ""}, nil, false},
+	"azurerm_media_services_account":                                   {"azurerm_media_services_account", "ams", 3, 24, true, "[^0-9a-z]", "^[a-z0-9]{3,24}$", false, "resourceGroup", false, OfficialData{"", "Azure Media Services Account", ""}, nil, false},
+	"azurerm_monitor_action_group":                                     {"azurerm_monitor_action_group", "amag", 1, 260, false, `[^~!@$^*()\[\]\{\}_\-="';,0-9A-Za-z _.-]`, "^[^|:<>+#%&\\?/]{0,259}[^|:<>+#%&\\?/. ]$", true, "resourceGroup", false, OfficialData{"", "Azure Monitor Action Group", ""}, nil, false},
+	"azurerm_monitor_action_rule_action_group":                         {"azurerm_monitor_action_rule_action_group", "marag", 1, 260, false, "[^0-9A-Za-z-_.]", "^[a-zA-Z0-9-_.]{1,260}$", true, "resourceGroup", false, OfficialData{"", "Azure Monitor Action Rule Action Group", ""}, nil, false},
+	"azurerm_monitor_action_rule_suppression":                          {"azurerm_monitor_action_rule_suppression", "mars", 1, 260, false, "[^0-9A-Za-z-_.]", "^[a-zA-Z0-9-_.]{1,260}$", true, "resourceGroup", false, OfficialData{"", "Azure Monitor Action Rule Suppression", ""}, nil, false},
+	"azurerm_monitor_activity_log_alert":                               {"azurerm_monitor_activity_log_alert", "adfmysql", 1, 260, false, "[^0-9A-Za-z<>*%:&?#\\+\\/]", "^[^<>*%:&?#\\+\\/]{0,259}[^<>*%:&.?#\\+\\/]$", true, "parent", false, OfficialData{"", "Azure Monitor Activity Log Alert", ""}, nil, false},
+	"azurerm_monitor_autoscale_setting":                                {"azurerm_monitor_autoscale_setting", "amas", 2, 64, false, "[^0-9A-Za-z _.-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,62}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Monitor Autoscale Setting", ""}, nil, false},
+	"azurerm_monitor_data_collection_endpoint":                         {"azurerm_monitor_data_collection_endpoint", "dce", 3, 44, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,42}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Monitor Data Collection Endpoint", ""}, nil, false},
+	"azurerm_monitor_data_collection_rule":                             {"azurerm_monitor_data_collection_rule", "dcr", 3, 44, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,42}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Monitor Data Collection Rule", ""}, nil, false},
+	"azurerm_monitor_diagnostic_setting":                               {"azurerm_monitor_diagnostic_setting", "amds", 1, 260, false, "[^0-9A-Za-z _.-]", "^[a-zA-Z0-9 ][a-zA-Z0-9-._ ]{0,258}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Monitor Diagnostic Setting", ""}, nil, false},
+	"azurerm_monitor_log_profile":                                      {"azurerm_monitor_log_profile", "mlp", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Monitor Log Profile", ""}, nil, false},
+	"azurerm_monitor_metric_alert":                                     {"azurerm_monitor_metric_alert", "ma", 1, 251, false, "[<>*%&:\\?+/#@{}]", "^[^<>*%&:\\?+/#@{}]{0,250}[^<>*%&:\\?+/#@{}. ]$", true, "resourceGroup", false, OfficialData{"", "Azure Monitor Metric Alert", ""}, nil, false},
+	"azurerm_monitor_private_link_scope":                               {"azurerm_monitor_private_link_scope", "ampls", 1, 255, false, "[^0-9A-Za-z-._()]", "^[0-9A-Za-z-._()]{0,254}[0-9A-Za-z-_()]$", true, "resourceGroup", false, OfficialData{"", "Azure Monitor Private Link Scope", ""}, nil, false},
+	"azurerm_monitor_scheduled_query_rules_alert":                      {"azurerm_monitor_scheduled_query_rules_alert", "schqra", 1, 260, false, "[<>*%&:\\?/#{}]", "^[^<>*%&:\\?/#{}]{0,259}[^<>*%&:\\?/#{}. ]$", true, "resourceGroup", false, OfficialData{"", "Azure Monitor Scheduled Query Rules Alert", ""}, nil, false},
+	"azurerm_monitor_scheduled_query_rules_log":                        {"azurerm_monitor_scheduled_query_rules_log", "msqrl", 1, 260, false, "[^0-9A-Za-z-_.]", "^[a-zA-Z0-9-_.]{1,260}$", true, "resourceGroup", false, OfficialData{"", "Azure Monitor Scheduled Query Rules Log", ""}, nil, false},
+	"azurerm_monitor_smart_detector_alert_rule":                        {"azurerm_monitor_smart_detector_alert_rule", "msdar", 1, 260, false, "[^0-9A-Za-z-_.]", "^[a-zA-Z0-9-_.]{1,260}$", true, "resourceGroup", false, OfficialData{"", "Azure Monitor Smart Detector Alert Rule", ""}, nil, false},
+	"azurerm_monitor_workspace":                                        {"azurerm_monitor_workspace", "amw", 4, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{2,61}[a-zA-Z0-9]$", true, "resourceGroup", true, OfficialData{"", "Azure Monitor Workspace", ""}, nil, false},
+	"azurerm_mssql_database":                                           {"azurerm_mssql_database", "sqldb", 1, 128, false, "[<>*%:.?\\+\\/ ]", "^[^<>*%:.?\\+\\/]{1,127}[^<>*%:.?\\+\\/ ]$", true, "parent", false, OfficialData{"sqldb", "Azure SQL database", "Microsoft.Sql/servers/databases"}, nil, false},
+	"azurerm_mssql_elasticpool":                                        {"azurerm_mssql_elasticpool", "sqlep", 1, 128, false, "[<>*%:.?\\+\\/]", "^[^<>*%:.?\\+\\/]{1,127}[^<>*%:.?\\+\\/ ]$", true, "parent", false, OfficialData{"", "Azure Mssql Elasticpool", ""}, nil, true},
+	"azurerm_mssql_mi":                                                 {"azurerm_mssql_mi", "sqlmi", 1, 63, true, "[^0-9A-Za-z-]", "^[a-z0-9][a-z0-9-]{0,61}[a-z0-9]$", true, "global", false, OfficialData{"", "Azure Mssql Mi", ""}, nil, false},
+	"azurerm_mssql_server":                                             {"azurerm_mssql_server", "sql", 1, 63, true, "[^0-9A-Za-z-]", "^[a-z0-9][a-z0-9-]{0,61}[a-z0-9]$", true, "global", false, OfficialData{"sql", "Azure SQL Database server", "Microsoft.Sql/servers"}, nil, true},
+	"azurerm_mysql_database":                                           {"azurerm_mysql_database", "mysqldb", 1, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,63}$", true, "parent", false, OfficialData{"", "Azure Mysql Database", ""}, nil, false},
+	"azurerm_mysql_firewall_rule":                                      {"azurerm_mysql_firewall_rule", "mysqlfw", 1, 128, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent", false, OfficialData{"", "Azure Mysql Firewall Rule", ""}, nil, false},
+	"azurerm_mysql_flexible_server":                                    {"azurerm_mysql_flexible_server", "mysqlf", 3, 63, false, "[^0-9A-Za-z-]", "^[a-z0-9][a-zA-Z0-9-]{1,61}[a-z0-9]$", true, "global", false, OfficialData{"", "Azure Mysql Flexible Server", ""}, nil, false},
+	"azurerm_mysql_flexible_server_database":                           {"azurerm_mysql_flexible_server_database", "mysqlfdb", 1, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,63}$", true, "parent", false, OfficialData{"", "Azure Mysql Flexible Server Database", ""}, nil, false},
+	"azurerm_mysql_flexible_server_firewall_rule":                      {"azurerm_mysql_flexible_server_firewall_rule", "mysqlffw", 1, 128, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent", false, OfficialData{"", "Azure Mysql Flexible Server Firewall Rule", ""}, nil, false},
+	"azurerm_mysql_server":                                             {"azurerm_mysql_server", "mysql", 3, 63, false, "[^0-9A-Za-z-]", "^[a-z0-9][a-zA-Z0-9-]{1,61}[a-z0-9]$", true, "global", false, OfficialData{"mysql", "Azure Database for MySQL server", "Microsoft.DBforMySQL/servers"}, nil, false},
+	"azurerm_mysql_virtual_network_rule":                               {"azurerm_mysql_virtual_network_rule", "mysqlvn", 1, 128, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent", false, OfficialData{"", "Azure Mysql Virtual Network Rule", ""}, nil, false},
+	"azurerm_nat_gateway":                                              {"azurerm_nat_gateway", "ng", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"ng", "NAT gateway", "Microsoft.Network/natGateways"}, nil, false},
+	"azurerm_netapp_account":                                           {"azurerm_netapp_account", "ana", 1, 128, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9][a-zA-Z0-9-_]{0,126}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Netapp Account", ""}, nil, false},
+	"azurerm_netapp_pool":                                              {"azurerm_netapp_pool", "anp", 1, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9][a-zA-Z0-9-_]{0,61}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Netapp Pool", ""}, nil, false},
+	"azurerm_netapp_snapshot":                                          {"azurerm_netapp_snapshot", "ans", 1, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9][a-zA-Z0-9-_]{0,61}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Netapp Snapshot", ""}, nil, false},
+	"azurerm_netapp_volume":                                            {"azurerm_netapp_volume", "anv", 1, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9][a-zA-Z0-9-_]{0,61}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Netapp Volume", ""}, nil, false},
+	"azurerm_network_connection_monitor":                               {"azurerm_network_connection_monitor", "cm", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Network Connection Monitor", ""}, nil, false},
+	"azurerm_network_ddos_protection_plan":                             {"azurerm_network_ddos_protection_plan", "ddospp", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", true, OfficialData{"", "Azure Network Ddos Protection Plan", ""}, nil, false},
+	"azurerm_network_interface":                                        {"azurerm_network_interface", "nic", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"nic", "Network interface", "Microsoft.Network/networkInterfaces"}, nil, false},
+	"azurerm_network_profile":                                          {"azurerm_network_profile", "npr", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Network Profile", ""}, nil, false},
+	"azurerm_network_security_group":                                   {"azurerm_network_security_group", "nsg", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"nsg", "Network security group", "Microsoft.Network/networkSecurityGroups"}, nil, false},
+	"azurerm_network_security_group_rule":                              {"azurerm_network_security_group_rule", "nsgr", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Network Security Group Rule", ""}, nil, false},
+	"azurerm_network_security_rule":                                    {"azurerm_network_security_rule", "nsgr", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Network Security Rule", ""}, nil, true},
+	"azurerm_network_watcher":                                          {"azurerm_network_watcher", "nw", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Network Watcher", ""}, nil, false},
+	"azurerm_nginx_deployment":                                         {"azurerm_nginx_deployment", "nginx", 1, 30, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9]([a-zA-Z0-9-]{0,28}[a-zA-Z0-9])?$", true, "resourceGroup", false, OfficialData{"", "Azure Nginx Deployment", ""}, nil, false},
+	"azurerm_notification_hub":                                         {"azurerm_notification_hub", "nh", 1, 260, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,259}$", true, "parent", false, OfficialData{"", "Azure Notification Hub", ""}, nil, false},
+	"azurerm_notification_hub_authorization_rule":                      {"azurerm_notification_hub_authorization_rule", "dnsrec", 1, 256, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,255}$", true, "parent", false, OfficialData{"", "Azure Notification Hub Authorization Rule", ""}, nil, false},
+	"azurerm_notification_hub_namespace":                               {"azurerm_notification_hub_namespace", "dnsrec", 6, 50, false, "[^0-9A-Za-z-]", "^[a-zA-Z][a-zA-Z0-9-]{4,48}[a-zA-Z0-9]$", true, "global", false, OfficialData{"", "Azure Notification Hub Namespace", ""}, nil, false},
+	"azurerm_orchestrated_virtual_machine_scale_set":                   {"azurerm_orchestrated_virtual_machine_scale_set", "ovmss", 1, 64, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,62}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Orchestrated Virtual Machine Scale Set", ""}, nil, false},
+	"azurerm_point_to_site_vpn_gateway":                                {"azurerm_point_to_site_vpn_gateway", "vpngw", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Point To Site Vpn Gateway", ""}, nil, false},
+	"azurerm_policy_definition":                                        {"azurerm_policy_definition", "pold", 1, 64, false, "[^0-9A-Za-z-_.()]", "^[a-zA-Z0-9-_.()]{1,64}$", true, "parent", false, OfficialData{"", "Azure Policy Definition", ""}, nil, false},
+	"azurerm_policy_remediation":                                       {"azurerm_policy_remediation", "polr", 1, 64, false, "[^0-9A-Za-z-_.()]", "^[a-zA-Z0-9-_.()]{1,64}$", true, "parent", false, OfficialData{"", "Azure Policy Remediation", ""}, nil, false},
+	"azurerm_policy_set_definition":                                    {"azurerm_policy_set_definition", "polsd", 1, 64, false, "[^0-9A-Za-z-_.()]", "^[a-zA-Z0-9-_.()]{1,64}$", true, "parent", false, OfficialData{"", "Azure Policy Set Definition", ""}, nil, false},
+	"azurerm_portal_dashboard":                                         {"azurerm_portal_dashboard", "dsb", 3, 160, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{3,160}$", true, "parent", false, OfficialData{"", "Azure Portal Dashboard", ""}, nil, true},
+	"azurerm_postgresql_database":                                      {"azurerm_postgresql_database", "psqldb", 1, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,63}$", true, "parent", false, OfficialData{"", "Azure Postgresql Database", ""}, nil, false},
+	"azurerm_postgresql_firewall_rule":                                 {"azurerm_postgresql_firewall_rule", "psqlfw", 1, 128, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent", false, OfficialData{"", "Azure Postgresql Firewall Rule", ""}, nil, false},
+	"azurerm_postgresql_flexible_server":                               {"azurerm_postgresql_flexible_server", "psqlf", 3, 63, true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$", true, "global", false, OfficialData{"", "Azure Postgresql Flexible Server", ""}, nil, false},
+	"azurerm_postgresql_flexible_server_database":                      {"azurerm_postgresql_flexible_server_database", "psqlfdb", 1, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,63}$", true, "parent", false, OfficialData{"", "Azure Postgresql Flexible Server Database", ""}, nil, false},
+	"azurerm_postgresql_flexible_server_firewall_rule":                 {"azurerm_postgresql_flexible_server_firewall_rule", "psqlffw", 1, 128, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent", false, OfficialData{"", "Azure Postgresql Flexible Server Firewall Rule", ""}, nil, false},
+	"azurerm_postgresql_server":                                        {"azurerm_postgresql_server", "psql", 3, 63, false, "[^0-9A-Za-z-]", "^[a-z0-9][a-zA-Z0-9-]{1,61}[a-z0-9]$", true, "global", false, OfficialData{"psql", "Azure Database for PostgreSQL server", "Microsoft.DBforPostgreSQL/servers"}, nil, false},
+	"azurerm_postgresql_virtual_network_rule":                          {"azurerm_postgresql_virtual_network_rule", "psqlvn", 1, 128, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,128}$", true, "parent", false, OfficialData{"", "Azure Postgresql Virtual Network Rule", ""}, nil, false},
+	"azurerm_powerbi_embedded":                                         {"azurerm_powerbi_embedded", "pbi", 3, 63, false, "[^0-9a-z]", "^[a-z0-9][a-z0-9]{2,62}$", false, "region", false, OfficialData{"", "Azure Powerbi Embedded", ""}, nil, false},
+	"azurerm_private_dns_a_record":                                     {"azurerm_private_dns_a_record", "pdnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", true, OfficialData{"", "Azure Private Dns A Record", ""}, nil, false},
+	"azurerm_private_dns_aaaa_record":                                  {"azurerm_private_dns_aaaa_record", "pdnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", true, OfficialData{"", "Azure Private Dns Aaaa Record", ""}, nil, false},
+	"azurerm_private_dns_cname_record":                                 {"azurerm_private_dns_cname_record", "pdnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", true, OfficialData{"", "Azure Private Dns Cname Record", ""}, nil, false},
+	"azurerm_private_dns_mx_record":                                    {"azurerm_private_dns_mx_record", "pdnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", true, OfficialData{"", "Azure Private Dns Mx Record", ""}, nil, false},
+	"azurerm_private_dns_ptr_record":                                   {"azurerm_private_dns_ptr_record", "pdnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", true, OfficialData{"", "Azure Private Dns Ptr Record", ""}, nil, false},
+	"azurerm_private_dns_resolver":                                     {"azurerm_private_dns_resolver", "dnspr", 3, 80, false, "[^0-9A-Za-z_-]", "^[a-zA-Z][a-zA-Z0-9-_]{1,78}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Private Dns Resolver", ""}, nil, false},
+	"azurerm_private_dns_resolver_dns_forwarding_ruleset":              {"azurerm_private_dns_resolver_dns_forwarding_ruleset", "dnsfwrs", 2, 80, false, "[^0-9A-Za-z_-]", "^[a-zA-Z][a-zA-Z0-9-_]{0,78}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Private Dns Resolver Dns Forwarding Ruleset", ""}, nil, false},
+	"azurerm_private_dns_resolver_forwarding_rule":                     {"azurerm_private_dns_resolver_forwarding_rule", "dnsfwr", 1, 80, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9]([a-zA-Z0-9-_]{0,78}[a-zA-Z0-9])?$", true, "parent", false, OfficialData{"", "Azure Private Dns Resolver Forwarding Rule", ""}, nil, false},
+	"azurerm_private_dns_resolver_inbound_endpoint":                    {"azurerm_private_dns_resolver_inbound_endpoint", "dnsprie", 3, 80, false, "[^0-9A-Za-z_-]", "^[a-zA-Z][a-zA-Z0-9-_]{1,78}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Private Dns Resolver Inbound Endpoint", ""}, nil, false},
+	"azurerm_private_dns_resolver_outbound_endpoint":                   {"azurerm_private_dns_resolver_outbound_endpoint", "dnsproe", 3, 80, false, "[^0-9A-Za-z_-]", "^[a-zA-Z][a-zA-Z0-9-_]{1,78}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Private Dns Resolver Outbound Endpoint", ""}, nil, false},
+	"azurerm_private_dns_resolver_virtual_network_link":                {"azurerm_private_dns_resolver_virtual_network_link", "dnsfwrsvnetl", 1, 80, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9]([a-zA-Z0-9-_]{0,78}[a-zA-Z0-9])?$", true, "parent", false, OfficialData{"", "Azure Private Dns Resolver Virtual Network Link", ""}, nil, false},
+	"azurerm_private_dns_srv_record":                                   {"azurerm_private_dns_srv_record", "pdnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", true, OfficialData{"", "Azure Private Dns Srv Record", ""}, nil, false},
+	"azurerm_private_dns_txt_record":                                   {"azurerm_private_dns_txt_record", "pdnsrec", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", true, OfficialData{"", "Azure Private Dns Txt Record", ""}, nil, false},
+	"azurerm_private_dns_zone":                                         {"azurerm_private_dns_zone", "pdns", 1, 63, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,61}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Private Dns Zone", ""}, nil, false},
+	"azurerm_private_dns_zone_group":                                   {"azurerm_private_dns_zone_group", "pdnszg", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", true, OfficialData{"", "Azure Private Dns Zone Group", ""}, nil, false},
+	"azurerm_private_dns_zone_virtual_network_link":                    {"azurerm_private_dns_zone_virtual_network_link", "pnetlk", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Private Dns Zone Virtual Network Link", ""}, nil, false},
+	"azurerm_private_endpoint":                                         {"azurerm_private_endpoint", "pe", 2, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9_.-]{0,62}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Private Endpoint", ""}, nil, false},
+	"azurerm_private_link_service":                                     {"azurerm_private_link_service", "pls", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", true, OfficialData{"", "Azure Private Link Service", ""}, nil, false},
+	"azurerm_private_service_connection":                               {"azurerm_private_service_connection", "psc", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", true, OfficialData{"", "Azure Private Service Connection", ""}, nil, false},
+	"azurerm_proximity_placement_group":                                {"azurerm_proximity_placement_group", "ppg", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", true, OfficialData{"", "Azure Proximity Placement Group", ""}, nil, false},
+	"azurerm_public_ip":                                                {"azurerm_public_ip", "pip", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"pip", "Public IP address", "Microsoft.Network/publicIPAddresses"}, nil, false},
+	"azurerm_public_ip_prefix":                                         {"azurerm_public_ip_prefix", "pippf", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Public Ip Prefix", ""}, nil, false},
+	"azurerm_purview_account":                                          {"azurerm_purview_account", "purv", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9_-]$", true, "subscription", false, OfficialData{"", "Azure Purview Account", ""}, nil, false},
+	"azurerm_recovery_services_vault":                                  {"azurerm_recovery_services_vault", "rsv", 2, 50, false, `[^a-zA-Z0-9-]`, "^[a-zA-Z][a-zA-Z0-9-]{1,49}$", true, "resourceGroup", false, OfficialData{"", "Azure Recovery Services Vault", ""}, nil, false},
+	"azurerm_recovery_services_vault_backup_police":                    {"azurerm_recovery_services_vault_backup_police", "rsvbp", 3, 150, false, `[^a-zA-Z0-9-]`, "^[a-zA-Z][a-zA-Z0-9\\-]{1,148}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Recovery Services Vault Backup Police", ""}, nil, false},
+	"azurerm_redhat_openshift_cluster":                                 {"azurerm_redhat_openshift_cluster", "aroc", 1, 30, false, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{1,30}$", false, "resourceGroup", false, OfficialData{"", "Azure Redhat Openshift Cluster", ""}, nil, false},
+	"azurerm_redhat_openshift_domain":                                  {"azurerm_redhat_openshift_domain", "arod", 1, 30, false, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{1,30}$", false, "resourceGroup", false, OfficialData{"", "Azure Redhat Openshift Domain", ""}, nil, false},
+	"azurerm_redis_cache":                                              {"azurerm_redis_cache", "redis", 1, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{0,61}[a-zA-Z0-9]$", true, "global", false, OfficialData{"redis", "Azure Cache for Redis", "Microsoft.Cache/Redis"}, nil, false},
+	"azurerm_redis_firewall_rule":                                      {"azurerm_redis_firewall_rule", "redisfw", 1, 256, false, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{1,256}$", false, "parent", false, OfficialData{"", "Azure Redis Firewall Rule", ""}, nil, false},
+	"azurerm_relay_hybrid_connection":                                  {"azurerm_relay_hybrid_connection", "rlhc", 1, 260, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,258}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Relay Hybrid Connection", ""}, nil, false},
+	"azurerm_relay_namespace":                                          {"azurerm_relay_namespace", "rln", 6, 50, false, "[^0-9A-Za-z-]", "^[a-zA-Z][a-zA-Z0-9-]{4,48}[a-zA-Z0-9]$", true, "global", false, OfficialData{"", "Azure Relay Namespace", ""}, nil, false},
+	"azurerm_resource_group":                                           {"azurerm_resource_group", "rg", 1, 90, false, `[^a-zA-Z0-9-._\\(\\)]`, "^[a-zA-Z0-9-._\\(\\)]{0,89}[a-zA-Z0-9-_\\(\\)]$", true, "subscription", false, OfficialData{"rg", "Resource group", "Microsoft.Resources/resourceGroups"}, nil, false},
+	"azurerm_resource_group_policy_assignment":                         {"azurerm_resource_group_policy_assignment", "argpa", 1, 128, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,126}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Resource Group Policy Assignment", ""}, nil, false},
+	"azurerm_resource_group_template_deployment":                       {"azurerm_resource_group_template_deployment", "rgtd", 1, 64, false, "[^0-9A-Za-z-_.()]", "^[a-zA-Z0-9-_.()]{1,64}$", true, "resourceGroup", false, OfficialData{"", "Azure Resource Group Template Deployment", ""}, nil, false},
+	"azurerm_role_assignment":                                          {"azurerm_role_assignment", "ra", 1, 64, false, "[^0-9A-Za-z_.-]", "^[^%]{0,63}[^ %.]$", true, "assignment", false, OfficialData{"", "Azure Role Assignment", ""}, nil, false},
+	"azurerm_role_definition":                                          {"azurerm_role_definition", "rd", 1, 64, false, "[^0-9A-Za-z_.-]", "^[^%]{0,63}[^ %.]$", true, "definition", false, OfficialData{"", "Azure Role Definition", ""}, nil, false},
+	"azurerm_route":                                                    {"azurerm_route", "rt", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"rt", "Route table", "Microsoft.Network/routeTables"}, nil, false},
+	"azurerm_route_filter":                                             {"azurerm_route_filter", "rf", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Route Filter", ""}, nil, false},
+	"azurerm_route_server":                                             {"azurerm_route_server", "rts", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Route Server", ""}, nil, false},
+	"azurerm_route_table":                                              {"azurerm_route_table", "route", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Route Table", ""}, nil, false},
+	"azurerm_search_service":                                           {"azurerm_search_service", "srch", 2, 60, true, "[a-z0-9-]*--[a-z0-9-]*$", "^[a-z0-9](?:[a-z0-9-]{0,58}[a-z0-9])?$", true, "global", false, OfficialData{"srch", "Azure Search", "Microsoft.Search/searchServices"}, nil, false},
+	"azurerm_security_center_automation":                               {"azurerm_security_center_automation", "sca", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Security Center Automation", ""}, nil, false},
+	"azurerm_security_center_contact":                                  {"azurerm_security_center_contact", "scc", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Security Center Contact", ""}, nil, false},
+	"azurerm_sentinel_alert_rule_ms_security_incident":                 {"azurerm_sentinel_alert_rule_ms_security_incident", "sentarms", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Sentinel Alert Rule Ms Security Incident", ""}, nil, false},
+	"azurerm_sentinel_alert_rule_scheduled":                            {"azurerm_sentinel_alert_rule_scheduled", "sentars", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Sentinel Alert Rule Scheduled", ""}, nil, false},
+	"azurerm_service_fabric_cluster":                                   {"azurerm_service_fabric_cluster", "sf", 4, 23, true, "[^0-9a-z-]", "^[a-z][a-z0-9-]{2,21}[a-z0-9]$", true, "region", false, OfficialData{"sf", "Service Fabric cluster", "Microsoft.ServiceFabric/clusters"}, nil, false},
+	"azurerm_service_fabric_mesh_application":                          {"azurerm_service_fabric_mesh_application", "sfmesha", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Service Fabric Mesh Application", ""}, nil, false},
+	"azurerm_service_fabric_mesh_local_network":                        {"azurerm_service_fabric_mesh_local_network", "sfmeshln", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Service Fabric Mesh Local Network", ""}, nil, false},
+	"azurerm_service_fabric_mesh_secret":                               {"azurerm_service_fabric_mesh_secret", "sfmeshs", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Service Fabric Mesh Secret", ""}, nil, false},
+	"azurerm_service_plan":                                             {"azurerm_service_plan", "asp", 1, 40, false, "[^0-9A-Za-z-]", "^[0-9A-Za-z-]{1,40}$", true, "resourceGroup", false, OfficialData{"", "Azure Service Plan", ""}, nil, false},
+	"azurerm_servicebus_namespace":                                     {"azurerm_servicebus_namespace", "sb", 6, 50, false, "[^0-9A-Za-z-]", "^[a-zA-Z][a-zA-Z0-9-]{4,48}[a-zA-Z0-9]$", true, "global", false, OfficialData{"sb", "Service Bus namespace", "Microsoft.ServiceBus/namespaces"}, nil, false},
+	"azurerm_servicebus_namespace_authorization_rule":                  {"azurerm_servicebus_namespace_authorization_rule", "sbar", 1, 50, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Servicebus Namespace Authorization Rule", ""}, nil, false},
+	"azurerm_servicebus_namespace_disaster_recovery_config":            {"azurerm_servicebus_namespace_disaster_recovery_config", "sbdr", 1, 50, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Servicebus Namespace Disaster Recovery Config", ""}, nil, false},
+	"azurerm_servicebus_queue":                                         {"azurerm_servicebus_queue", "sbq", 1, 260, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,258}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"sbq", "Service Bus queue", "Microsoft.ServiceBus/namespaces/queues"}, nil, false},
+	"azurerm_servicebus_queue_authorization_rule":                      {"azurerm_servicebus_queue_authorization_rule", "sbqar", 1, 50, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Servicebus Queue Authorization Rule", ""}, nil, false},
+	"azurerm_servicebus_subscription":                                  {"azurerm_servicebus_subscription", "sbs", 1, 50, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Servicebus Subscription", ""}, nil, false},
+	"azurerm_servicebus_subscription_rule":                             {"azurerm_servicebus_subscription_rule", "sbsr", 1, 50, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Servicebus Subscription Rule", ""}, nil, false},
+	"azurerm_servicebus_topic":                                         {"azurerm_servicebus_topic", "sbt", 1, 260, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,258}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"sbt", "Service Bus topic", "Microsoft.ServiceBus/namespaces/topics"}, nil, false},
+	"azurerm_servicebus_topic_authorization_rule":                      {"azurerm_servicebus_topic_authorization_rule", "sbtar", 1, 50, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9]$", true, "parent", false, OfficialData{"", "Azure Servicebus Topic Authorization Rule", ""}, nil, false},
+	"azurerm_shared_image":                                             {"azurerm_shared_image", "si", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Shared Image", ""}, nil, false},
+	"azurerm_shared_image_gallery":                                     {"azurerm_shared_image_gallery", "sig", 1, 80, false, "[^0-9A-Za-z_.]", "^[a-zA-Z0-9][a-zA-Z0-9._]{0,78}[a-zA-Z0-9]$", false, "resourceGroup", false, OfficialData{"", "Azure Shared Image Gallery", ""}, nil, false},
+	"azurerm_signalr_service":                                          {"azurerm_signalr_service", "sgnlr", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$", true, "global", false, OfficialData{"", "Azure Signalr Service", ""}, nil, false},
+	"azurerm_site_recovery_fabric":                                     {"azurerm_site_recovery_fabric", "asrf", 1, 128, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,126}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Site Recovery Fabric", ""}, nil, false},
+	"azurerm_site_recovery_protection_container":                       {"azurerm_site_recovery_protection_container", "asrpc", 1, 128, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,126}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Site Recovery Protection Container", ""}, nil, false},
+	"azurerm_site_recovery_replicated_vm":                              {"azurerm_site_recovery_replicated_vm", "asrrvm", 1, 128, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,126}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Site Recovery Replicated Vm", ""}, nil, false},
+	"azurerm_site_recovery_replication_policy":                         {"azurerm_site_recovery_replication_policy", "asrrp", 1, 128, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,126}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Site Recovery Replication Policy", ""}, nil, false},
+	"azurerm_snapshot":                                                 {"azurerm_snapshot", "snp", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Snapshot", ""}, nil, false},
+	"azurerm_snapshots":                                                {"azurerm_snapshots", "snap", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"snap", "Snapshot", "Microsoft.Compute/snapshots"}, nil, false},
+	"azurerm_spatial_anchors_account":                                  {"azurerm_spatial_anchors_account", "spaa", 1, 90, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,88}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Spatial Anchors Account", ""}, nil, false},
+	"azurerm_spring_cloud_app":                                         {"azurerm_spring_cloud_app", "spca", 4, 32, true, "[^0-9a-z-]", "^[a-z][a-z0-9-]{2,30}[a-z0-9]$", true, "parent", false, OfficialData{"", "Azure Spring Cloud App", ""}, nil, false},
+	"azurerm_spring_cloud_certificate":                                 {"azurerm_spring_cloud_certificate", "spcert", 1, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,62}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Spring Cloud Certificate", ""}, nil, false},
+	"azurerm_spring_cloud_service":                                     {"azurerm_spring_cloud_service", "spcs", 4, 32, true, "[^0-9a-z-]", "^[a-z][a-z0-9-]{2,30}[a-z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Spring Cloud Service", ""}, nil, false},
+	"azurerm_sql_database":                                             {"azurerm_sql_database", "sqld", 1, 128, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,126}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Sql Database", ""}, nil, false},
+	"azurerm_sql_elasticpool":                                          {"azurerm_sql_elasticpool", "sqlep", 1, 128, false, "[<>*%:.?\\+\\/]", "^[^<>*%:.?\\+\\/]{1,127}[^<>*%:.?\\+\\/ ]$", true, "parent", false, OfficialData{"", "Azure Sql Elasticpool", ""}, nil, false},
+	"azurerm_sql_failover_group":                                       {"azurerm_sql_failover_group", "sqlfg", 1, 63, true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{0,61}[a-z0-9]$", true, "global", false, OfficialData{"", "Azure Sql Failover Group", ""}, nil, false},
+	"azurerm_sql_firewall_rule":                                        {"azurerm_sql_firewall_rule", "sqlfw", 1, 128, false, `[<>*%:?\\+\\/]`, "^[^<>*%:?\\+\\/]{1,127}[^<>*%:.?\\+\\/]$", true, "parent", false, OfficialData{"", "Azure Sql Firewall Rule", ""}, nil, false},
+	"azurerm_sql_server":                                               {"azurerm_sql_server", "sql", 1, 63, true, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{0,61}[a-z0-9]$", true, "global", false, OfficialData{"sql", "Azure SQL Database server", "Microsoft.Sql/servers"}, nil, false},
+	"azurerm_static_site":                                              {"azurerm_static_site", "stapp", 1, 40, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9-]{1,40}$", true, "resourceGroup", false, OfficialData{"", "Azure Static Site", ""}, nil, false},
+	"azurerm_storage_account":                                          {"azurerm_storage_account", "st", 3, 24, true, "[^0-9a-z]", "^[a-z0-9]{3,24}$", false, "global", false, OfficialData{"st", "Storage account", "Microsoft.Storage/storageAccounts"}, nil, false},
+	"azurerm_storage_blob":                                             {"azurerm_storage_blob", "blob", 1, 1024, false, "[\\s\\/$#&]", "^[^\\s\\/$#&]{1,1000}[^\\s\\/$#&]{0,24}$", true, "parent", false, OfficialData{"", "Azure Storage Blob", ""}, nil, false},
+	"azurerm_storage_container":                                        {"azurerm_storage_container", "stct", 3, 63, false, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{2,62}$", true, "parent", false, OfficialData{"", "Azure Storage Container", ""}, nil, false},
+	"azurerm_storage_data_lake_gen2_filesystem":                        {"azurerm_storage_data_lake_gen2_filesystem", "stdl", 3, 63, false, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$", true, "parent", false, OfficialData{"", "Azure Storage Data Lake Gen2 Filesystem", ""}, nil, false},
+	"azurerm_storage_data_lake_gen2_path":                              {"azurerm_storage_data_lake_gen2_path", "stdlp", 1, 1024, false, "[\\s$#&]", "^[^\\s\\/$#&]{1,1000}[^\\s\\/$#&]{0,24}$", true, "parent", false, OfficialData{"", "Azure Storage Data Lake Gen2 Path", ""}, nil, false},
+	"azurerm_storage_encryption_scope":                                 {"azurerm_storage_encryption_scope", "stes", 4, 63, false, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{4,63}$", false, "parent", false, OfficialData{"", "Azure Storage Encryption Scope", ""}, nil, false},
+	"azurerm_storage_queue":                                            {"azurerm_storage_queue", "stq", 3, 63, false, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$", true, "parent", false, OfficialData{"", "Azure Storage Queue", ""}, nil, false},
+	"azurerm_storage_share":                                            {"azurerm_storage_share", "sts", 3, 63, false, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$", true, "parent", false, OfficialData{"", "Azure Storage Share", ""}, nil, true},
+	"azurerm_storage_share_directory":                                  {"azurerm_storage_share_directory", "sts", 3, 63, false, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$", true, "parent", false, OfficialData{"", "Azure Storage Share Directory", ""}, nil, false},
+	"azurerm_storage_sync":                                             {"azurerm_storage_sync", "stsy", 1, 260, false, "[<>*%:.?\\+\\/]", "^[^<>*%:.?\\+\\/]{0,259}[^<>*%:.?\\+\\/ ]$", true, "resourceGroup", false, OfficialData{"", "Azure Storage Sync", ""}, nil, false},
+	"azurerm_storage_sync_group":                                       {"azurerm_storage_sync_group", "stsg", 1, 260, false, "[<>*%:.?\\+\\/]", "^[^<>*%:.?\\+\\/]{0,259}[^<>*%:.?\\+\\/ ]$", true, "resourceGroup", false, OfficialData{"", "Azure Storage Sync Group", ""}, nil, false},
+	"azurerm_storage_table":                                            {"azurerm_storage_table", "stt", 3, 63, false, "[^0-9a-z-]", "^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$", true, "parent", false, OfficialData{"", "Azure Storage Table", ""}, nil, false},
+	"azurerm_stream_analytics_function_javascript_udf":                 {"azurerm_stream_analytics_function_javascript_udf", "asafunc", 3, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent", false, OfficialData{"", "Azure Stream Analytics Function Javascript Udf", ""}, nil, false},
+	"azurerm_stream_analytics_job":                                     {"azurerm_stream_analytics_job", "asa", 3, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "resourceGroup", false, OfficialData{"asa", "Azure Stream Analytics", "Microsoft.StreamAnalytics/streamingjobs"}, nil, false},
+	"azurerm_stream_analytics_output_blob":                             {"azurerm_stream_analytics_output_blob", "asaoblob", 3, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent", false, OfficialData{"", "Azure Stream Analytics Output Blob", ""}, nil, false},
+	"azurerm_stream_analytics_output_eventhub":                         {"azurerm_stream_analytics_output_eventhub", "asaoeh", 3, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent", false, OfficialData{"", "Azure Stream Analytics Output Eventhub", ""}, nil, false},
+	"azurerm_stream_analytics_output_mssql":                            {"azurerm_stream_analytics_output_mssql", "asaomssql", 3, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent", false, OfficialData{"", "Azure Stream Analytics Output Mssql", ""}, nil, false},
+	"azurerm_stream_analytics_output_servicebus_queue":                 {"azurerm_stream_analytics_output_servicebus_queue", "asaosbq", 3, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent", false, OfficialData{"", "Azure Stream Analytics Output Servicebus Queue", ""}, nil, false},
+	"azurerm_stream_analytics_output_servicebus_topic":                 {"azurerm_stream_analytics_output_servicebus_topic", "asaosbt", 3, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent", false, OfficialData{"", "Azure Stream Analytics Output Servicebus Topic", ""}, nil, false},
+	"azurerm_stream_analytics_reference_input_blob":                    {"azurerm_stream_analytics_reference_input_blob", "asarblob", 3, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent", false, OfficialData{"", "Azure Stream Analytics Reference Input Blob", ""}, nil, false},
+	"azurerm_stream_analytics_stream_input_blob":                       {"azurerm_stream_analytics_stream_input_blob", "asaiblob", 3, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent", false, OfficialData{"", "Azure Stream Analytics Stream Input Blob", ""}, nil, false},
+	"azurerm_stream_analytics_stream_input_eventhub":                   {"azurerm_stream_analytics_stream_input_eventhub", "asaieh", 3, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent", false, OfficialData{"", "Azure Stream Analytics Stream Input Eventhub", ""}, nil, false},
+	"azurerm_stream_analytics_stream_input_iothub":                     {"azurerm_stream_analytics_stream_input_iothub", "asaiiot", 3, 63, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,63}$", true, "parent", false, OfficialData{"", "Azure Stream Analytics Stream Input Iothub", ""}, nil, false},
+	"azurerm_subnet":                                                   {"azurerm_subnet", "snet", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"snet", "Subnet", "Microsoft.Network/virtualNetworks/subnets"}, nil, false},
+	"azurerm_subscription_policy_assignment":                           {"azurerm_subscription_policy_assignment", "aspa", 1, 128, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,126}[a-zA-Z0-9_]$", true, "subscription", false, OfficialData{"", "Azure Subscription Policy Assignment", ""}, nil, false},
+	"azurerm_subscription_template_deployment":                         {"azurerm_subscription_template_deployment", "subtd", 1, 64, false, "[^0-9A-Za-z-_.()]", "^[a-zA-Z0-9-_.()]{1,64}$", true, "parent", false, OfficialData{"", "Azure Subscription Template Deployment", ""}, nil, false},
+	"azurerm_synapse_firewall_rule":                                    {"azurerm_synapse_firewall_rule", "syfw", 1, 128, false, `[<>*%:?\\+\\/]`, "^[^<>*%:?\\+\\/]{1,127}[^<>*%:.?\\+\\/]$", true, "parent", false, OfficialData{"", "Azure Synapse Firewall Rule", ""}, nil, false},
+	"azurerm_synapse_integration_runtime_azure":                        {"azurerm_synapse_integration_runtime_azure", "synira", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9-]$", true, "subscription", false, OfficialData{"", "Azure Synapse Integration Runtime Azure", ""}, nil, false},
+	"azurerm_synapse_integration_runtime_self_hosted":                  {"azurerm_synapse_integration_runtime_self_hosted", "synirsh", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9_-]$", true, "subscription", false, OfficialData{"", "Azure Synapse Integration Runtime Self Hosted", ""}, nil, false},
+	"azurerm_synapse_linked_service":                                   {"azurerm_synapse_linked_service", "synls", 1, 140, false, "[^0-9A-Za-z_]", "^[a-zA-Z0-9_]{1,140}$", false, "subscription", false, OfficialData{"", "Azure Synapse Linked Service", ""}, nil, false},
+	"azurerm_synapse_managed_private_endpoint":                         {"azurerm_synapse_managed_private_endpoint", "synmpe", 3, 63, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9_.-]{1,61}[a-zA-Z0-9_]$", true, "subscription", false, OfficialData{"", "Azure Synapse Managed Private Endpoint", ""}, nil, false},
+	"azurerm_synapse_private_link_hub":                                 {"azurerm_synapse_private_link_hub", "synplh", 1, 45, true, "[^0-9a-z]", "^[a-z0-9]{1,45}$", false, "subscription", false, OfficialData{"", "Azure Synapse Private Link Hub", ""}, nil, false},
+	"azurerm_synapse_spark_pool":                                       {"azurerm_synapse_spark_pool", "sysp", 1, 15, true, "[^0-9a-zA-Z]", "^[0-9a-zA-Z]{1,15}$", false, "parent", false, OfficialData{"", "Azure Synapse Spark Pool", ""}, nil, false},
+	"azurerm_synapse_sql_pool":                                         {"azurerm_synapse_sql_pool", "synsp", 3, 15, false, "[^0-9A-Za-z_]", "^[a-zA-Z0-9][a-zA-Z0-9_]{1,13}[a-zA-Z0-9]$", false, "subscription", false, OfficialData{"", "Azure Synapse Sql Pool", ""}, nil, false},
+	"azurerm_synapse_sql_pool_vulnerability_assessment_baseline":       {"azurerm_synapse_sql_pool_vulnerability_assessment_baseline", "synspvab", 3, 63, false, "[^0-9A-Za-z]", "^[a-zA-Z0-9]{1,63}$", false, "subscription", false, OfficialData{"", "Azure Synapse Sql Pool Vulnerability Assessment Baseline", ""}, nil, false},
+	"azurerm_synapse_sql_pool_workload_classifier":                     {"azurerm_synapse_sql_pool_workload_classifier", "synspwc", 1, 128, false, `[^-\w\._\(\)]`, "^[^<>*%:?\\+\\/]{1,127}[^<>*%:.?\\+\\/]$", true, "subscription", false, OfficialData{"", "Azure Synapse Sql Pool Workload Classifier", ""}, nil, false},
+	"azurerm_synapse_sql_pool_workload_group":                          {"azurerm_synapse_sql_pool_workload_group", "synspwg", 1, 128, false, `[^-\w\._\(\)]`, "^[^<>*%:.?\\+\\/]{0,127}[^<>*%:.?\\+\\/ ]$", true, "subscription", false, OfficialData{"", "Azure Synapse Sql Pool Workload Group", ""}, nil, false},
+	"azurerm_synapse_workspace":                                        {"azurerm_synapse_workspace", "syws", 1, 45, true, "[^0-9a-z]", "^[0-9a-z]{1,45}$", false, "resourceGroup", false, OfficialData{"", "Azure Synapse Workspace", ""}, nil, false},
+	"azurerm_template_deployment":                                      {"azurerm_template_deployment", "deploy", 1, 64, false, "[^0-9A-Za-z-._\\(\\)]", "^[a-zA-Z0-9-._\\(\\)]{1,64}$", true, "resourceGroup", false, OfficialData{"", "Azure Template Deployment", ""}, nil, false},
+	"azurerm_traffic_manager_profile":                                  {"azurerm_traffic_manager_profile", "traf", 1, 63, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-.]{0,61}[a-zA-Z0-9_]$", true, "global", false, OfficialData{"", "Azure Traffic Manager Profile", ""}, nil, false},
+	"azurerm_user_assigned_identity":                                   {"azurerm_user_assigned_identity", "msi", 3, 128, true, "[^0-9a-zA-Z-_]", "^[0-9a-zA-Z][0-9a-zA-Z-_]{2,127}$", true, "parent", false, OfficialData{"", "Azure User Assigned Identity", ""}, nil, false},
+	"azurerm_virtual_desktop_application_group":                        {"azurerm_virtual_desktop_application_group", "dag", 1, 260, false, "[^0-9A-Za-z _.-]", "^[a-zA-Z0-9 ][a-zA-Z0-9-._ ]{0,258}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Virtual Desktop Application Group", ""}, nil, false},
+	"azurerm_virtual_desktop_host_pool":                                {"azurerm_virtual_desktop_host_pool", "hpool", 1, 260, false, "[^0-9A-Za-z _.-]", "^[a-zA-Z0-9 ][a-zA-Z0-9-._ ]{0,258}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Virtual Desktop Host Pool", ""}, nil, false},
+	"azurerm_virtual_desktop_workspace":                                {"azurerm_virtual_desktop_workspace", "wvdws", 1, 260, false, "[^0-9A-Za-z _.-]", "^[a-zA-Z0-9 ][a-zA-Z0-9-._ ]{0,258}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Virtual Desktop Workspace", ""}, nil, false},
+	"azurerm_virtual_hub":                                              {"azurerm_virtual_hub", "vhub", 1, 50, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,48}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Virtual Hub", ""}, nil, false},
+	"azurerm_virtual_hub_bgp_connection":                               {"azurerm_virtual_hub_bgp_connection", "vhbgp", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Virtual Hub Bgp Connection", ""}, nil, false},
+	"azurerm_virtual_hub_connection":                                   {"azurerm_virtual_hub_connection", "vhcon", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Virtual Hub Connection", ""}, nil, false},
+	"azurerm_virtual_hub_ip":                                           {"azurerm_virtual_hub_ip", "vhip", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Virtual Hub Ip", ""}, nil, false},
+	"azurerm_virtual_hub_route_table":                                  {"azurerm_virtual_hub_route_table", "vhrt", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Virtual Hub Route Table", ""}, nil, false},
+	"azurerm_virtual_hub_security_partner_provider":                    {"azurerm_virtual_hub_security_partner_provider", "vhspp", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Virtual Hub Security Partner Provider", ""}, nil, false},
+	"azurerm_virtual_machine":                                          {"azurerm_virtual_machine", "vm", 1, 15, false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,13}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup", false, OfficialData{"vm", "Virtual machine", "Microsoft.Compute/virtualMachines"}, nil, false},
+	"azurerm_virtual_machine_extension":                                {"azurerm_virtual_machine_extension", "vmx", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", true, OfficialData{"", "Azure Virtual Machine Extension", ""}, nil, false},
+	"azurerm_virtual_machine_portal_name":                              {"azurerm_virtual_machine_portal_name", "vm", 1, 64, false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,62}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup", false, OfficialData{"vm", "Virtual machine", "Microsoft.Compute/virtualMachines"}, nil, false},
+	"azurerm_virtual_machine_scale_set":                                {"azurerm_virtual_machine_scale_set", "vmss", 1, 15, false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,13}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup", false, OfficialData{"vmss", "Virtual machine scale set", "Microsoft.Compute/virtualMachineScaleSets"}, nil, false},
+	"azurerm_virtual_machine_scale_set_extension":                      {"azurerm_virtual_machine_scale_set_extension", "vmssx", 1, 80, false, "[^a-zA-Z0-9\\-\\._]", "^[a-zA-Z0-9][a-zA-Z0-9\\-\\._]{0,78}[a-zA-Z0-9_]$", true, "parent", true, OfficialData{"", "Azure Virtual Machine Scale Set Extension", ""}, nil, false},
+	"azurerm_virtual_network":                                          {"azurerm_virtual_network", "vnet", 2, 64, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,62}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"vnet", "Virtual network", "Microsoft.Network/virtualNetworks"}, nil, false},
+	"azurerm_virtual_network_gateway":                                  {"azurerm_virtual_network_gateway", "vgw", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"vgw", "Virtual network gateway", "Microsoft.Network/virtualNetworkGateways"}, nil, false},
+	"azurerm_virtual_network_gateway_connection":                       {"azurerm_virtual_network_gateway_connection", "vngc", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Virtual Network Gateway Connection", ""}, nil, false},
+	"azurerm_virtual_network_peering":                                  {"azurerm_virtual_network_peering", "vpeer", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Virtual Network Peering", ""}, nil, false},
+	"azurerm_virtual_wan":                                              {"azurerm_virtual_wan", "vwan", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Virtual Wan", ""}, nil, false},
+	"azurerm_vm_windows_computer_name_prefix":                          {"azurerm_vm_windows_computer_name_prefix", "cn", 1, 9, false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,7}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup", false, OfficialData{"cn", "Connection", "Microsoft.Network/connections"}, nil, false},
+	"azurerm_vmware_cluster":                                           {"azurerm_vmware_cluster", "vwc", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Vmware Cluster", ""}, nil, false},
+	"azurerm_vmware_express_route_authorization":                       {"azurerm_vmware_express_route_authorization", "vwera", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Vmware Express Route Authorization", ""}, nil, false},
+	"azurerm_vmware_private_cloud":                                     {"azurerm_vmware_private_cloud", "vwpc", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Vmware Private Cloud", ""}, nil, false},
+	"azurerm_vpn_gateway":                                              {"azurerm_vpn_gateway", "vpng", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-_.]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", false, OfficialData{"", "Azure Vpn Gateway", ""}, nil, false},
+	"azurerm_vpn_gateway_connection":                                   {"azurerm_vpn_gateway_connection", "vcn", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Vpn Gateway Connection", ""}, nil, false},
+	"azurerm_vpn_server_configuration":                                 {"azurerm_vpn_server_configuration", "vpnsc", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "resourceGroup", true, OfficialData{"", "Azure VPN Server Configuration", ""}, nil, false},
+	"azurerm_vpn_site":                                                 {"azurerm_vpn_site", "vst", 1, 80, false, "[^0-9A-Za-z_.-]", "^[a-zA-Z0-9][a-zA-Z0-9-._]{0,78}[a-zA-Z0-9_]$", true, "parent", false, OfficialData{"", "Azure Vpn Site", ""}, nil, false},
+	"azurerm_web_application_firewall_policy":                          {"azurerm_web_application_firewall_policy", "wafw", 1, 80, false, "[^0-9A-Za-z]", "^[a-zA-Z0-9][a-zA-Z0-9]{0,78}[a-zA-Z0-9]$", false, "global", false, OfficialData{"", "Azure Web Application Firewall Policy", ""}, nil, false},
+	"azurerm_web_pubsub":                                               {"azurerm_web_pubsub", "ps", 3, 63, false, "[^0-9A-Za-z-]", "^[a-zA-Z][-a-zA-Z0-9]{1,61}[a-zA-Z0-9]$", true, "resourceGroup", false, OfficialData{"", "Azure Web Pubsub", ""}, nil, false},
+	"azurerm_web_pubsub_hub":                                           {"azurerm_web_pubsub_hub", "pshub", 1, 128, false, "[^0-9A-Za-z_`,.\\[\\]]", "^[a-zA-Z][a-zA-Z0-9_`,.\\[\\]]{0,127}$", false, "parent", false, OfficialData{"", "Azure Web Pubsub Hub", ""}, nil, false},
+	"azurerm_windows_function_app":                                     {"azurerm_windows_function_app", "fa", 2, 60, false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,58}[0-9a-zA-Z]$", true, "global", false, OfficialData{"", "Azure Windows Function App", ""}, nil, false},
+	"azurerm_windows_function_app_slot":                                {"azurerm_windows_function_app_slot", "fas", 2, 59, false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,57}[0-9a-zA-Z]$", true, "global", false, OfficialData{"", "Azure Windows Function App Slot", ""}, nil, false},
+	"azurerm_windows_virtual_machine":                                  {"azurerm_windows_virtual_machine", "vm", 1, 15, false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,13}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup", false, OfficialData{"vm", "Virtual machine", "Microsoft.Compute/virtualMachines"}, []string{"vmw"}, false},
+	"azurerm_windows_virtual_machine_scale_set":                        {"azurerm_windows_virtual_machine_scale_set", "vmss", 1, 15, false, "[\\/\"\\[\\]:|<>+=;,?*@&_]", "^[^\\/\"\\[\\]:|<>+=;,?*@&_][^\\/\"\\[\\]:|<>+=;,?*@&]{0,13}[^\\/\"\\[\\]:|<>+=;,?*@&.-]$", true, "resourceGroup", false, OfficialData{"vmss", "Virtual machine scale set", "Microsoft.Compute/virtualMachineScaleSets"}, nil, false},
+	"azurerm_windows_web_app":                                          {"azurerm_windows_web_app", "wwapp", 2, 60, false, "[^0-9A-Za-z-]", "^[0-9A-Za-z][0-9A-Za-z-]{0,58}[0-9a-zA-Z]$", true, "global", false, OfficialData{"", "Azure Windows Web App", ""}, nil, false},
+	"databricks_cluster":                                               {"databricks_cluster", "dbc", 3, 30, false, "[^a-zA-Z0-9-_]", "^[a-zA-Z0-9-_]{3,30}$", true, "parent", true, OfficialData{"", "Azure Databricks Cluster", ""}, nil, false},
+	"databricks_high_concurrency_cluster":                              {"databricks_high_concurrency_cluster", "dbhcc", 3, 30, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{3,30}$", true, "parent", true, OfficialData{"", "Azure Databricks High Concurrency Cluster", ""}, nil, false},
+	"databricks_standard_cluster":                                      {"databricks_standard_cluster", "dbsc", 3, 30, false, "[^a-zA-Z0-9-_]", "^[a-zA-Z0-9-_]{3,30}$", true, "parent", true, OfficialData{"", "Azure Databricks Standard Cluster", ""}, nil, false},
+	"general":                                                          {"general", "", 1, 250, false, "[^0-9A-Za-z_-]", "^[a-zA-Z0-9-_]{1,250}$", true, "global", true, OfficialData{"", "Azure General", ""}, nil, true},
+	"general_safe":                                                     {"general_safe", "", 1, 250, true, "[^a-z]", "^[a-z]{1,250}$", false, "global", true, OfficialData{"", "Azure General Safe", ""}, nil, false},
 }
 
 // ResourceMaps are a map from the slug to the resource definition
@@ -683,7 +683,6 @@ var ResourceMaps = map[string]string{
 	"dnspr":        "azurerm_private_dns_resolver",
 	"dnsprie":      "azurerm_private_dns_resolver_inbound_endpoint",
 	"dnsproe":      "azurerm_private_dns_resolver_outbound_endpoint",
-	"dnsrec":       "azurerm_dns_a_record",
 	"dpbpb":        "azurerm_data_protection_backup_policy_blob_storage",
 	"dpbpd":        "azurerm_data_protection_backup_policy_disk",
 	"dpbpp":        "azurerm_data_protection_backup_policy_postgresql",
@@ -692,7 +691,7 @@ var ResourceMaps = map[string]string{
 	"dps":          "azurerm_iothub_dps",
 	"dpscert":      "azurerm_iothub_dps_certificate",
 	"dpssap":       "azurerm_iothub_dps_shared_access_policy",
-	"dsb":          "azurerm_dashboard",
+	"dsb":          "azurerm_portal_dashboard",
 	"dsc":          "azurerm_devspace_controller",
 	"dshr":         "azurerm_data_share",
 	"dshra":        "azurerm_data_share_account",
@@ -765,7 +764,6 @@ var ResourceMaps = map[string]string{
 	"kvk":          "azurerm_key_vault_key",
 	"kvs":          "azurerm_key_vault_secret",
 	"lab":          "azurerm_dev_test_lab",
-	"labvm":        "azurerm_dev_test_linux_virtual_machine",
 	"laer":         "azurerm_log_analytics_data_export_rule",
 	"lapp":         "azurerm_logic_app_workflow",
 	"lappac":       "azurerm_logic_app_action_custom",
@@ -822,12 +820,11 @@ var ResourceMaps = map[string]string{
 	"npr":          "azurerm_network_profile",
 	"npw":          "aks_node_pool_windows",
 	"nsg":          "azurerm_network_security_group",
-	"nsgr":         "azurerm_network_security_group_rule",
+	"nsgr":         "azurerm_network_security_rule",
 	"nw":           "azurerm_network_watcher",
 	"ovmss":        "azurerm_orchestrated_virtual_machine_scale_set",
 	"pbi":          "azurerm_powerbi_embedded",
 	"pdns":         "azurerm_private_dns_zone",
-	"pdnsrec":      "azurerm_private_dns_a_record",
 	"pdnszg":       "azurerm_private_dns_zone_group",
 	"pe":           "azurerm_private_endpoint",
 	"pip":          "azurerm_public_ip",
@@ -939,9 +936,9 @@ var ResourceMaps = map[string]string{
 	"vhrt":         "azurerm_virtual_hub_route_table",
 	"vhspp":        "azurerm_virtual_hub_security_partner_provider",
 	"vhub":         "azurerm_virtual_hub",
-	"vm":           "azurerm_linux_virtual_machine",
-	"vmss":         "azurerm_linux_virtual_machine_scale_set",
+	"vml":          "azurerm_linux_virtual_machine",
 	"vmssx":        "azurerm_virtual_machine_scale_set_extension",
+	"vmw":          "azurerm_windows_virtual_machine",
 	"vmx":          "azurerm_virtual_machine_extension",
 	"vnet":         "azurerm_virtual_network",
 	"vngc":         "azurerm_virtual_network_gateway_connection",
@@ -958,3 +955,13 @@ var ResourceMaps = map[string]string{
 	"wvdws":        "azurerm_virtual_desktop_workspace",
 	"wwapp":        "azurerm_windows_web_app",
 }
+
+// AmbiguousSlugs are a map from the slugs shared by several resource definitions
+// without a canonical one to the resource types sharing them
+var AmbiguousSlugs = map[string][]string{
+	"dnsrec":  {"azurerm_dns_a_record", "azurerm_dns_aaaa_record", "azurerm_dns_caa_record", "azurerm_dns_cname_record", "azurerm_dns_mx_record", "azurerm_dns_ns_record", "azurerm_dns_ptr_record", "azurerm_dns_txt_record", "azurerm_notification_hub_authorization_rule", "azurerm_notification_hub_namespace"},
+	"labvm":   {"azurerm_dev_test_linux_virtual_machine", "azurerm_dev_test_windows_virtual_machine"},
+	"pdnsrec": {"azurerm_private_dns_a_record", "azurerm_private_dns_aaaa_record", "azurerm_private_dns_cname_record", "azurerm_private_dns_mx_record", "azurerm_private_dns_ptr_record", "azurerm_private_dns_srv_record", "azurerm_private_dns_txt_record"},
+	"vm":      {"azurerm_linux_virtual_machine", "azurerm_virtual_machine", "azurerm_virtual_machine_portal_name", "azurerm_windows_virtual_machine"},
+	"vmss":    {"azurerm_linux_virtual_machine_scale_set", "azurerm_virtual_machine_scale_set", "azurerm_windows_virtual_machine_scale_set"},
+}
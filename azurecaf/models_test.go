@@ -0,0 +1,150 @@
+package azurecaf
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestHashSeq(t *testing.T) {
+	storageAccount := ResourceDefinitions["azurerm_storage_account"]
+	inputs := []string{"00000000-0000-0000-0000-000000000001", "prod"}
+
+	hash := hashSeq(inputs, 8, &storageAccount)
+	if len(hash) != 8 {
+		t.Fatalf("expected 8 characters, got %q", hash)
+	}
+	if !regexp.MustCompile(`^[0-9a-z]{8}$`).MatchString(hash) {
+		t.Errorf("expected lowercase letters and digits, got %q", hash)
+	}
+	if again := hashSeq(inputs, 8, &storageAccount); again != hash {
+		t.Errorf("expected the same inputs to give the same hash, got %q and %q", hash, again)
+	}
+	if other := hashSeq([]string{"00000000-0000-0000-0000-000000000002", "prod"}, 8, &storageAccount); other == hash {
+		t.Errorf("expected different inputs to give different hashes, got %q", other)
+	}
+	if joined := hashSeq([]string{"00000000-0000-0000-0000-000000000001prod"}, 8, &storageAccount); joined == hash {
+		t.Error("expected the boundaries between the inputs to be part of the hash")
+	}
+	if long := hashSeq(inputs, 100, &storageAccount); len(long) != 100 || long[:8] != hash {
+		t.Errorf("expected a 100 characters hash extending the short one, got %q", long)
+	}
+
+	lettersOnly := ResourceStructure{RegEx: "[^a-z]"}
+	if letters := hashSeq(inputs, 20, &lettersOnly); !regexp.MustCompile(`^[a-z]{20}$`).MatchString(letters) {
+		t.Errorf("expected letters only, got %q", letters)
+	}
+
+	if empty := hashSeq(nil, 8, &storageAccount); empty != "" {
+		t.Errorf("expected no hash without inputs, got %q", empty)
+	}
+}
+
+func TestGetNameResult_Hash(t *testing.T) {
+	raw := map[string]interface{}{
+		"name":           "myapp",
+		"resource_type":  "azurerm_storage_account",
+		"resource_types": []interface{}{"azurerm_resource_group"},
+		"hash_inputs":    []interface{}{"00000000-0000-0000-0000-000000000001"},
+		"hash_length":    5,
+	}
+	d := schema.TestResourceDataRaw(t, resourceName().Schema, raw)
+	if _, err := getNameResult(d, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result := d.Get("result").(string)
+	if !regexp.MustCompile(`^stmyapp[0-9a-z]{5}$`).MatchString(result) {
+		t.Errorf("expected the hash to follow the name, got %s", result)
+	}
+	rg := d.Get("results").(map[string]interface{})["azurerm_resource_group"].(string)
+	if rg != "rg-myapp-"+result[len(result)-5:] {
+		t.Errorf("expected the same hash for every resource type, got %s and %s", result, rg)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceName().Schema, raw)
+	if _, err := getNameResult(d, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if again := d.Get("result").(string); again != result {
+		t.Errorf("expected a stable name, got %s and %s", result, again)
+	}
+}
+
+func TestRandSeq(t *testing.T) {
+	seed := int64(42)
+	if a, b := randSeq(10, &seed), randSeq(10, &seed); a != b {
+		t.Errorf("expected the same seed to give the same value, got %s and %s", a, b)
+	}
+	if a, b := randSeq(32, nil), randSeq(32, nil); a == b {
+		t.Errorf("expected unseeded values to differ, got %s twice", a)
+	}
+	if empty := randSeq(0, nil); empty != "" {
+		t.Errorf("expected no character, got %s", empty)
+	}
+
+	// Every letter, 'z' included, must be generated
+	generated := randSeq(2000, &seed)
+	for _, c := range alphagenerator {
+		if !strings.ContainsRune(generated, c) {
+			t.Errorf("expected %c to be generated", c)
+		}
+	}
+}
+
+func TestRandomCharacters(t *testing.T) {
+	resourceGroup := ResourceDefinitions["azurerm_resource_group"]
+	storageAccount := ResourceDefinitions["azurerm_storage_account"]
+	lettersOnly := ResourceStructure{ResourceTypeName: "letters_only", RegEx: "[^a-zA-Z]"}
+
+	cases := []struct {
+		name         string
+		characterSet string
+		resource     ResourceStructure
+		expected     string
+		err          bool
+	}{
+		{"default", "", resourceGroup, "^[a-z]+$", false},
+		{"letters with uppercase", RandomCharactersLetters, resourceGroup, "^[a-zA-Z]+$", false},
+		{"letters of a lowercase type", RandomCharactersLetters, storageAccount, "^[a-z]+$", false},
+		{"alphanumeric", RandomCharactersAlphanumeric, storageAccount, "^[a-z0-9]+$", false},
+		{"alphanumeric without digits", RandomCharactersAlphanumeric, lettersOnly, "^[a-zA-Z]+$", false},
+		{"numeric", RandomCharactersNumeric, storageAccount, "^[0-9]+$", false},
+		{"numeric not allowed", RandomCharactersNumeric, lettersOnly, "", true},
+		{"unknown", "hex", storageAccount, "", true},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			alphabet, err := randomCharacters(tt.characterSet, &tt.resource)
+			if tt.err {
+				if err == nil {
+					t.Errorf("expected an error, got %s", string(alphabet))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !regexp.MustCompile(tt.expected).MatchString(string(alphabet)) {
+				t.Errorf("expected %s to match %s", string(alphabet), tt.expected)
+			}
+		})
+	}
+}
+
+func TestGetNameResult_RandomCharacterSet(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceName().Schema, map[string]interface{}{
+		"name":                 "myapp",
+		"resource_type":        "azurerm_storage_account",
+		"random_length":        6,
+		"random_seed":          123,
+		"random_character_set": RandomCharactersNumeric,
+	})
+	if _, err := getNameResult(d, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result := d.Get("result").(string); !regexp.MustCompile(`^stmyapp[0-9]{6}$`).MatchString(result) {
+		t.Errorf("expected 6 digits, got %s", result)
+	}
+}
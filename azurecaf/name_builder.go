@@ -1,6 +1,8 @@
 package azurecaf
 
 import (
+	"fmt"
+	"sort"
 	"strings"
 )
 
@@ -13,6 +15,18 @@ type NameBuilder struct {
 type NameSegment struct {
 	Value   string
 	Include bool
+	// Separator joins the segment to the previous one, the builder Separator is used when nil
+	Separator *string
+	// Kind is the format placeholder the segment comes from, e.g. name or slug
+	Kind string
+	// Priority orders the fitting of the segments, the higher priorities are fitted first
+	// and the lower ones are dropped first when the name exceeds MaxLength
+	Priority int
+	// PlaceholderPriority is the priority set on the placeholder with segment_priorities,
+	// the segments of the lowest one are cut first by the hash shortening
+	PlaceholderPriority int
+	// Required segments are always part of the name
+	Required bool
 }
 
 func NewNameBuilder(maxLength int, separator string) *NameBuilder {
@@ -40,28 +54,69 @@ func (b *NameBuilder) Prepend(segment string) {
 	b.content = append([]NameSegment{{Value: segment, Include: b.include(segment)}}, b.content...)
 }
 
-func (b NameBuilder) GetName() string {
-	return strings.Join(b.getAllContent(), b.Separator)
+// Add appends a segment which is not included yet and returns its index, the
+// segments are then included with FitSegments.
+func (b *NameBuilder) Add(segment NameSegment) int {
+	segment.Include = false
+	b.content = append(b.content, segment)
+	return len(b.content) - 1
 }
 
-func (b NameBuilder) GetTrimmedName() string {
-	return strings.Join(b.getIncludedContent(), b.Separator)
+// FitSegments includes the required segments, then the other ones by decreasing
+// priority as long as the name fits in MaxLength. Segments of the same priority are
+// fitted in the order they were added. It fails when the required segments alone
+// exceed MaxLength.
+func (b *NameBuilder) FitSegments() error {
+	for i := range b.content {
+		b.content[i].Include = b.content[i].Required
+	}
+	if required := b.GetTrimmedName(); len(required) > b.MaxLength {
+		return fmt.Errorf("required segments '%s' are %d characters long and exceed the maximum length of %d", required, len(required), b.MaxLength)
+	}
+
+	order := make([]int, 0, len(b.content))
+	for i, segment := range b.content {
+		if !segment.Required {
+			order = append(order, i)
+		}
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return b.content[order[i]].Priority > b.content[order[j]].Priority
+	})
+	for _, i := range order {
+		b.content[i].Include = true
+		if len(b.GetTrimmedName()) > b.MaxLength {
+			b.content[i].Include = false
+		}
+	}
+	return nil
+}
+
+func (b NameBuilder) GetName() string {
+	return b.join(b.content)
 }
 
-func (b NameBuilder) getIncludedContent() []string {
-	values := make([]string, 0, len(b.content))
+func (b NameBuilder) GetTrimmedName() string {
+	included := make([]NameSegment, 0, len(b.content))
 	for _, segment := range b.content {
 		if segment.Include {
-			values = append(values, segment.Value)
+			included = append(included, segment)
 		}
 	}
-	return values
+	return b.join(included)
 }
 
-func (b NameBuilder) getAllContent() []string {
-	values := make([]string, 0, len(b.content))
-	for _, segment := range b.content {
-		values = append(values, segment.Value)
+func (b NameBuilder) join(segments []NameSegment) string {
+	var name strings.Builder
+	for i, segment := range segments {
+		if i > 0 {
+			if segment.Separator != nil {
+				name.WriteString(*segment.Separator)
+			} else {
+				name.WriteString(b.Separator)
+			}
+		}
+		name.WriteString(segment.Value)
 	}
-	return values
+	return name.String()
 }
@@ -233,3 +233,75 @@ func TestNameBuilder_Prepend(t *testing.T) {
 		})
 	}
 }
+
+func TestNameBuilder_FitSegments(t *testing.T) {
+	tests := []struct {
+		name      string
+		maxLength int
+		segments  []NameSegment
+		wantName  string
+		wantErr   bool
+	}{
+		{
+			name:      "higher priority fitted first",
+			maxLength: 12,
+			segments: []NameSegment{
+				{Value: "prd", Priority: 3},
+				{Value: "rg", Priority: 1},
+				{Value: "billing", Priority: 2},
+			},
+			wantName: "prd-billing",
+		},
+		{
+			name:      "same priority in insertion order",
+			maxLength: 10,
+			segments: []NameSegment{
+				{Value: "rg"},
+				{Value: "billing"},
+				{Value: "prd"},
+			},
+			wantName: "rg-billing",
+		},
+		{
+			name:      "required before priority",
+			maxLength: 10,
+			segments: []NameSegment{
+				{Value: "prd", Required: true},
+				{Value: "billing", Priority: 5},
+				{Value: "weu", Priority: 1},
+			},
+			wantName: "prd-weu",
+		},
+		{
+			name:      "required segments exceed max length",
+			maxLength: 8,
+			segments: []NameSegment{
+				{Value: "prd", Required: true},
+				{Value: "billing", Required: true},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := NewNameBuilder(tt.maxLength, "-")
+			for _, segment := range tt.segments {
+				builder.Add(segment)
+			}
+			err := builder.FitSegments()
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected an error, got %q", builder.GetTrimmedName())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := builder.GetTrimmedName(); got != tt.wantName {
+				t.Errorf("GetTrimmedName() = %q, want %q", got, tt.wantName)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package azurecaf
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Case styles of the generated names.
+const (
+	// CasePreserve keeps the case of the inputs, the names of the lowercase resource types are lowercased
+	CasePreserve = "preserve"
+	// CaseLower lowercases the name
+	CaseLower = "lower"
+	// CaseUpper uppercases the name
+	CaseUpper = "upper"
+	// CasePascal capitalizes every word of the prefixes, slug, name, suffixes and custom
+	// segments, the separator between the segments is kept
+	CasePascal = "pascal"
+	// CaseCamel capitalizes every word as CasePascal does, except the first letter of the name
+	CaseCamel = "camel"
+)
+
+var nameCases = []string{CasePreserve, CaseLower, CaseUpper, CasePascal, CaseCamel}
+
+// checkNameCase returns an error when the resource type does not allow the letters
+// of the case style, e.g. upper for a lowercase resource type.
+func checkNameCase(resource *ResourceStructure, nameCase string) error {
+	lower := len(allowedCharacters(resource, alphagenerator)) > 0
+	upper := !resource.LowerCase && len(allowedCharacters(resource, uppergenerator)) > 0
+	var allowed bool
+	switch nameCase {
+	case "", CasePreserve:
+		return nil
+	case CaseLower:
+		allowed = lower
+	case CaseUpper:
+		allowed = upper
+	case CasePascal, CaseCamel:
+		allowed = lower && upper
+	default:
+		return fmt.Errorf("invalid case %q, expected one of %v", nameCase, nameCases)
+	}
+	if allowed {
+		return nil
+	}
+	if resource.LowerCase {
+		return fmt.Errorf("case %s is not allowed for the resource type %s, its names are lowercase", nameCase, resource.ResourceTypeName)
+	}
+	return fmt.Errorf("case %s is not allowed for the resource type %s, its pattern %s does not accept both lowercase and uppercase letters", nameCase, resource.ResourceTypeName, resource.RegEx)
+}
+
+// caseSegment applies the pascal and camel styles to a segment written by the user.
+// Its words, delimited by the characters which are neither letters nor digits, are
+// capitalized and joined. The other styles are applied to the whole name.
+func caseSegment(value string, nameCase string) string {
+	if nameCase != CasePascal && nameCase != CaseCamel {
+		return value
+	}
+	words := strings.FieldsFunc(value, func(r rune) bool {
+		isLetter := strings.ToLower(string(r)) != strings.ToUpper(string(r))
+		return !isLetter && (r < '0' || r > '9')
+	})
+	for i, word := range words {
+		first, size := utf8.DecodeRuneInString(word)
+		words[i] = strings.ToUpper(string(first)) + strings.ToLower(word[size:])
+	}
+	return strings.Join(words, "")
+}
+
+// caseSegments applies caseSegment to every value, in a new slice.
+func caseSegments(values []string, nameCase string) []string {
+	cased := make([]string, len(values))
+	for i, value := range values {
+		cased[i] = caseSegment(value, nameCase)
+	}
+	return cased
+}
+
+// caseName applies the case style to the composed name.
+func caseName(name string, nameCase string) string {
+	switch nameCase {
+	case CaseLower:
+		return strings.ToLower(name)
+	case CaseUpper:
+		return strings.ToUpper(name)
+	case CaseCamel:
+		first, size := utf8.DecodeRuneInString(name)
+		if first == utf8.RuneError {
+			return name
+		}
+		return strings.ToLower(string(first)) + name[size:]
+	}
+	return name
+}
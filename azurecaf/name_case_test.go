@@ -0,0 +1,119 @@
+package azurecaf
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestCheckNameCase(t *testing.T) {
+	storage := ResourceDefinitions["azurerm_storage_account"]
+	group := ResourceDefinitions["azurerm_resource_group"]
+	digits := ResourceStructure{ResourceTypeName: "contoso_counter", RegEx: "[^0-9]"}
+
+	cases := []struct {
+		resource *ResourceStructure
+		nameCase string
+		wantErr  string
+	}{
+		{&storage, CasePreserve, ""},
+		{&storage, CaseLower, ""},
+		{&storage, CaseUpper, "its names are lowercase"},
+		{&storage, CasePascal, "its names are lowercase"},
+		{&group, CaseUpper, ""},
+		{&group, CaseCamel, ""},
+		{&digits, CaseLower, "its pattern [^0-9] does not accept both lowercase and uppercase letters"},
+		{&group, "title", `invalid case "title"`},
+	}
+	for _, tt := range cases {
+		err := checkNameCase(tt.resource, tt.nameCase)
+		if tt.wantErr == "" && err != nil {
+			t.Errorf("%s %s: unexpected error %v", tt.resource.ResourceTypeName, tt.nameCase, err)
+		}
+		if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+			t.Errorf("%s %s: expected %q, got %v", tt.resource.ResourceTypeName, tt.nameCase, tt.wantErr, err)
+		}
+	}
+}
+
+func TestCaseSegment(t *testing.T) {
+	cases := map[string]string{
+		"orders api": "OrdersApi",
+		"ORDERS-API": "OrdersApi",
+		"v2_orders":  "V2Orders",
+		"élan vital": "ÉlanVital",
+		"":           "",
+		"--":         "",
+	}
+	for value, expected := range cases {
+		if cased := caseSegment(value, CasePascal); cased != expected {
+			t.Errorf("%q: expected %q, got %q", value, expected, cased)
+		}
+	}
+	if cased := caseSegment("orders api", CaseUpper); cased != "orders api" {
+		t.Errorf("expected the upper case to be applied to the whole name, got %q", cased)
+	}
+}
+
+func TestGetResourceNameForDefinition_Case(t *testing.T) {
+	group := ResourceDefinitions["azurerm_resource_group"]
+	cases := map[string]string{
+		CasePreserve: "dev-rg-Ordersapi-x",
+		CaseLower:    "dev-rg-ordersapi-x",
+		CaseUpper:    "DEV-RG-ORDERSAPI-X",
+		CasePascal:   "Dev-Rg-OrdersApi-X",
+		CaseCamel:    "dev-Rg-OrdersApi-X",
+	}
+	for nameCase, expected := range cases {
+		inputs := nameInputs{Name: "Orders api", Prefixes: []string{"dev"}, Suffixes: []string{"x"}, Separator: "-", CleanInput: true, UseSlug: true, Case: nameCase}
+		name, err := getResourceNameForDefinition(&group, inputs, "", ConventionCafClassic)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %v", nameCase, err)
+		}
+		if name != expected {
+			t.Errorf("%s: expected %s, got %s", nameCase, expected, name)
+		}
+	}
+}
+
+func TestGetResourceNameForDefinition_CaseSeparator(t *testing.T) {
+	// The words of a segment are joined, the segments keep their separator
+	group := ResourceDefinitions["azurerm_resource_group"]
+	cases := map[string]string{
+		"-": "Prd-Rg-OrdersApi",
+		"":  "PrdRgOrdersApi",
+	}
+	for separator, expected := range cases {
+		inputs := nameInputs{Name: "orders api", Prefixes: []string{"prd"}, Separator: separator, CleanInput: true, UseSlug: true, Case: CasePascal}
+		name, err := getResourceNameForDefinition(&group, inputs, "", ConventionCafClassic)
+		if err != nil || name != expected {
+			t.Errorf("separator %q: expected %s, got %s %v", separator, expected, name, err)
+		}
+	}
+}
+
+func TestResourceName_Case(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceName().Schema, map[string]interface{}{
+		"name":           "orders",
+		"resource_type":  "azurerm_resource_group",
+		"resource_types": []interface{}{"azurerm_storage_account"},
+		"case":           "upper",
+	})
+	if _, err := getNameResult(d, nil); err == nil || !strings.Contains(err.Error(), "azurerm_storage_account, its names are lowercase") {
+		t.Errorf("expected the storage account to refuse the upper case, got %v", err)
+	}
+
+	d = schema.TestResourceDataRaw(t, dataName().Schema, map[string]interface{}{
+		"name":          "orders",
+		"resource_type": "azurerm_resource_group",
+		"case":          "upper",
+	})
+	if diags := dataNameRead(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if result := d.Get("result").(string); result != "RG-ORDERS" {
+		t.Errorf("expected RG-ORDERS, got %s", result)
+	}
+}
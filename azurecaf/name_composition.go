@@ -0,0 +1,144 @@
+package azurecaf
+
+import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+// nameComposition describes how a name was composed: its segments in the order of
+// the format and the length left within the maximum length of the resource type.
+type nameComposition struct {
+	MaxLength int
+	// RemainingLength is the number of characters the name could still take
+	RemainingLength int
+	Segments        []compositionSegment
+}
+
+// compositionSegment is a prefix, slug, name, random, hash, suffix or custom segment
+// of a name.
+type compositionSegment struct {
+	// Kind is prefix, slug, name, random, hash, suffix or the key of a custom placeholder
+	Kind string
+	// Original is the value of the input, Cleaned the value once cased, transliterated
+	// and cleaned, before the shortening and the truncation
+	Original string
+	Cleaned  string
+	// Included tells whether the segment is part of the name, it is left out when its
+	// cleaned value is empty or when it does not fit in the maximum length
+	Included bool
+}
+
+// segmentKind returns the kind of the segments of a placeholder, in the singular for
+// the lists.
+func segmentKind(key string) string {
+	switch key {
+	case PlaceholderPrefixes:
+		return "prefix"
+	case PlaceholderSuffixes:
+		return "suffix"
+	}
+	return key
+}
+
+// composedSegments lists the segments of the placeholders of the format, with their
+// original and cleaned values. segments are the ones composed from the non-empty
+// cleaned values, in the same order. The placeholders without input are left out.
+func composedSegments(format *nameFormat, originals map[string][]string, values map[string][]string, segments []NameSegment) []compositionSegment {
+	composed := []compositionSegment{}
+	next := 0
+	for _, placeholder := range format.Placeholders {
+		for i, value := range values[placeholder.Key] {
+			original := ""
+			if i < len(originals[placeholder.Key]) {
+				original = originals[placeholder.Key][i]
+			}
+			included := false
+			if value != "" {
+				if next < len(segments) {
+					included = segments[next].Include
+				}
+				next++
+			}
+			if original == "" && value == "" {
+				continue
+			}
+			composed = append(composed, compositionSegment{Kind: segmentKind(placeholder.Key), Original: original, Cleaned: value, Included: included})
+		}
+	}
+	return composed
+}
+
+// flattenNameComposition returns the composition of the name of a resource type in
+// the shape of the composition attribute.
+func flattenNameComposition(resourceType string, composition nameComposition) map[string]interface{} {
+	segments := make([]interface{}, 0, len(composition.Segments))
+	for _, segment := range composition.Segments {
+		segments = append(segments, map[string]interface{}{
+			"kind":     segment.Kind,
+			"original": segment.Original,
+			"cleaned":  segment.Cleaned,
+			"included": segment.Included,
+		})
+	}
+	return map[string]interface{}{
+		"resource_type":    resourceType,
+		"max_length":       composition.MaxLength,
+		"remaining_length": composition.RemainingLength,
+		"segments":         segments,
+	}
+}
+
+// compositionSchema returns the schema of the composition attribute of azurecaf_name
+// and of its data source.
+func compositionSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"resource_type": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Resource type of the name, as set in resource_type or resource_types.",
+				},
+				"max_length": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Maximum length of the names of the resource type.",
+				},
+				"remaining_length": {
+					Type:        schema.TypeInt,
+					Computed:    true,
+					Description: "Number of characters left between the length of the name and max_length.",
+				},
+				"segments": {
+					Type:        schema.TypeList,
+					Computed:    true,
+					Description: "Segments of the name, in the order of the format.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"kind": {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "Kind of segment: prefix, slug, name, random, hash, suffix or the key of a custom placeholder of the format.",
+							},
+							"original": {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "Value of the input.",
+							},
+							"cleaned": {
+								Type:        schema.TypeString,
+								Computed:    true,
+								Description: "Value once cased, transliterated and cleaned, before the shortening and the truncation of the name.",
+							},
+							"included": {
+								Type:        schema.TypeBool,
+								Computed:    true,
+								Description: "Whether the segment is part of the name. A segment is left out when its cleaned value is empty or when it does not fit in max_length.",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
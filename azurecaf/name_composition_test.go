@@ -0,0 +1,107 @@
+package azurecaf
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestGenerateResourceName_Composition(t *testing.T) {
+	widget := &ResourceStructure{ResourceTypeName: "contoso_widget", CafPrefix: "wdg", MinLength: 1, MaxLength: 16, RegEx: alphanumh, ValidationRegExp: `^[a-z0-9-]{1,16}$`, LowerCase: true}
+	inputs := nameInputs{Name: "orders_api", Prefixes: []string{"contoso", "dev"}, Suffixes: []string{"!!"}, Separator: "-", CleanInput: true, UseSlug: true}
+	generated, err := generateResourceName(widget, inputs, "x1", ConventionCafClassic)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if generated.Name != "wdg-ordersapi-x1" {
+		t.Fatalf("expected wdg-ordersapi-x1, got %s", generated.Name)
+	}
+	expected := nameComposition{
+		MaxLength:       16,
+		RemainingLength: 0,
+		Segments: []compositionSegment{
+			{Kind: "prefix", Original: "contoso", Cleaned: "contoso", Included: false},
+			{Kind: "prefix", Original: "dev", Cleaned: "dev", Included: false},
+			{Kind: "slug", Original: "wdg", Cleaned: "wdg", Included: true},
+			{Kind: "name", Original: "orders_api", Cleaned: "ordersapi", Included: true},
+			{Kind: "random", Original: "x1", Cleaned: "x1", Included: true},
+			{Kind: "suffix", Original: "!!", Cleaned: "", Included: false},
+		},
+	}
+	if !reflect.DeepEqual(generated.Composition, expected) {
+		t.Errorf("unexpected composition %+v", generated.Composition)
+	}
+
+	// The segments kept ahead of the hash of a shortened name are included
+	inputs.Shortening = ShorteningHash
+	generated, err = generateResourceName(widget, inputs, "", ConventionCafClassic)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	included := []bool{}
+	for _, segment := range generated.Composition.Segments {
+		included = append(included, segment.Included)
+	}
+	if !reflect.DeepEqual(included, []bool{true, true, false, false, false}) {
+		t.Errorf("expected contoso and dev ahead of the hash of %s, got %v", generated.Name, included)
+	}
+
+	// A passthrough name is a single segment
+	generated, err = generateResourceName(widget, nameInputs{Name: "widget-01", Passthrough: true}, "", ConventionCafClassic)
+	if err != nil || !reflect.DeepEqual(generated.Composition.Segments, []compositionSegment{{Kind: "name", Original: "widget-01", Cleaned: "widget-01", Included: true}}) || generated.Composition.RemainingLength != 7 {
+		t.Errorf("unexpected passthrough composition %+v %v", generated.Composition, err)
+	}
+}
+
+func TestResourceName_Composition(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceName().Schema, map[string]interface{}{
+		"name":           "orders",
+		"prefixes":       []interface{}{"dev"},
+		"resource_type":  "azurerm_resource_group",
+		"resource_types": []interface{}{"azurerm_storage_account"},
+	})
+	if _, err := getNameResult(d, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	composition := d.Get("composition").([]interface{})
+	if len(composition) != 2 {
+		t.Fatalf("expected the composition of 2 names, got %v", composition)
+	}
+	group := composition[0].(map[string]interface{})
+	if group["resource_type"] != "azurerm_resource_group" || group["max_length"] != 90 || group["remaining_length"] != 90-len(d.Get("result").(string)) {
+		t.Errorf("unexpected composition %v", group)
+	}
+	storage := composition[1].(map[string]interface{})
+	segments := storage["segments"].([]interface{})
+	if storage["resource_type"] != "azurerm_storage_account" || len(segments) != 3 {
+		t.Fatalf("unexpected composition %v", storage)
+	}
+	if slug := segments[1].(map[string]interface{}); slug["kind"] != "slug" || slug["original"] != "st" || slug["included"] != true {
+		t.Errorf("unexpected slug segment %v", slug)
+	}
+}
+
+func TestDataName_Composition(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataName().Schema, map[string]interface{}{
+		"name":          "orders",
+		"prefixes":      []interface{}{"dev"},
+		"resource_type": "azurerm_storage_account",
+	})
+	if diags := dataNameRead(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	composition := d.Get("composition").([]interface{})
+	if len(composition) != 1 {
+		t.Fatalf("expected the composition of 1 name, got %v", composition)
+	}
+	storage := composition[0].(map[string]interface{})
+	segments := storage["segments"].([]interface{})
+	if storage["resource_type"] != "azurerm_storage_account" || storage["max_length"] != 24 || storage["remaining_length"] != 24-len(d.Get("result").(string)) || len(segments) != 3 {
+		t.Fatalf("unexpected composition %v", storage)
+	}
+	if prefix := segments[0].(map[string]interface{}); prefix["kind"] != "prefix" || prefix["original"] != "dev" || prefix["included"] != true {
+		t.Errorf("unexpected prefix segment %v", prefix)
+	}
+}
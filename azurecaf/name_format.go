@@ -0,0 +1,288 @@
+package azurecaf
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Placeholders filled by the naming inputs, any other placeholder is read from format_values.
+const (
+	PlaceholderName     = "name"
+	PlaceholderSlug     = "slug"
+	PlaceholderRandom   = "random"
+	PlaceholderHash     = "hash"
+	PlaceholderPrefixes = "prefixes"
+	PlaceholderSuffixes = "suffixes"
+)
+
+var builtinPlaceholders = []string{PlaceholderName, PlaceholderSlug, PlaceholderRandom, PlaceholderHash, PlaceholderPrefixes, PlaceholderSuffixes}
+
+// defaultNamePrecedence is the order the segments are fitted in the name when no
+// format is configured.
+var defaultNamePrecedence = []string{PlaceholderName, PlaceholderSlug, PlaceholderRandom, PlaceholderHash, PlaceholderSuffixes, PlaceholderPrefixes}
+
+var placeholderKeyRegEx = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// formatPlaceholder is a {key} element of a name format.
+type formatPlaceholder struct {
+	Key string
+	// Optional placeholders, written {key?}, are skipped when they have no value and
+	// are dropped when the name exceeds the maximum length
+	Optional bool
+	// Separator, written {key|separator}, joins the segment to the previous one in
+	// place of the separator attribute
+	Separator *string
+	// Priority, set with segment_priorities, moves the segments of the placeholder ahead
+	// of the ones of lower priority in the fitting order
+	Priority int
+}
+
+// nameFormat is the parsed form of the format attribute, e.g. {env}{slug}{workload}{region?}{instance?|}.
+type nameFormat struct {
+	Placeholders []formatPlaceholder
+	// precedence lists the indexes of the placeholders in the order their segments
+	// are fitted in the name
+	precedence []int
+}
+
+// parseNameFormat parses a name format template made of placeholders only.
+func parseNameFormat(template string) (*nameFormat, error) {
+	format := &nameFormat{}
+	seen := map[string]bool{}
+	rest := template
+	for len(rest) > 0 {
+		if rest[0] != '{' {
+			return nil, fmt.Errorf("invalid format %q: unexpected %q, the format must only contain {placeholders}", template, rest)
+		}
+		end := strings.IndexByte(rest, '}')
+		if end < 0 {
+			return nil, fmt.Errorf("invalid format %q: unterminated placeholder %q", template, rest)
+		}
+		placeholder, err := parseFormatPlaceholder(rest[1:end])
+		if err != nil {
+			return nil, fmt.Errorf("invalid format %q: %w", template, err)
+		}
+		if seen[placeholder.Key] {
+			return nil, fmt.Errorf("invalid format %q: placeholder {%s} is used more than once", template, placeholder.Key)
+		}
+		seen[placeholder.Key] = true
+		format.Placeholders = append(format.Placeholders, placeholder)
+		rest = rest[end+1:]
+	}
+	if len(format.Placeholders) == 0 {
+		return nil, fmt.Errorf("invalid format %q: at least one placeholder is required", template)
+	}
+
+	// Required segments are always part of the name, the optional ones are then
+	// fitted in the order of the template.
+	for _, optional := range []bool{false, true} {
+		for i, placeholder := range format.Placeholders {
+			if placeholder.Optional == optional {
+				format.precedence = append(format.precedence, i)
+			}
+		}
+	}
+	return format, nil
+}
+
+// hasPlaceholder reports whether the format lays out the placeholder key.
+func (f *nameFormat) hasPlaceholder(key string) bool {
+	for _, placeholder := range f.Placeholders {
+		if placeholder.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// validateNameFormat is the schema validation function of the format attribute.
+func validateNameFormat(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if _, err := parseNameFormat(v); err != nil {
+		return nil, []error{err}
+	}
+	return nil, nil
+}
+
+func parseFormatPlaceholder(content string) (formatPlaceholder, error) {
+	placeholder := formatPlaceholder{}
+	key := content
+	if i := strings.IndexByte(content, '|'); i >= 0 {
+		separator := content[i+1:]
+		placeholder.Separator = &separator
+		key = content[:i]
+	}
+	if strings.HasSuffix(key, "?") {
+		placeholder.Optional = true
+		key = strings.TrimSuffix(key, "?")
+	}
+	if !placeholderKeyRegEx.MatchString(key) {
+		return placeholder, fmt.Errorf("invalid placeholder {%s}, keys must start with a lowercase letter followed by lowercase letters, digits or underscores", content)
+	}
+	placeholder.Key = key
+	return placeholder, nil
+}
+
+// legacyNameFormat returns the format equivalent to the historical composition,
+// {prefixes?}{slug?}{name?}{random?}{hash?}{suffixes?}, fitting the segments in
+// the given precedence. The placeholders missing from the precedence are fitted last.
+func legacyNameFormat(namePrecedence []string) *nameFormat {
+	format := &nameFormat{}
+	for _, key := range []string{PlaceholderPrefixes, PlaceholderSlug, PlaceholderName, PlaceholderRandom, PlaceholderHash, PlaceholderSuffixes} {
+		format.Placeholders = append(format.Placeholders, formatPlaceholder{Key: key, Optional: true})
+	}
+	fitted := make([]bool, len(format.Placeholders))
+	for _, key := range namePrecedence {
+		for i, placeholder := range format.Placeholders {
+			if placeholder.Key == key && !fitted[i] {
+				format.precedence = append(format.precedence, i)
+				fitted[i] = true
+			}
+		}
+	}
+	for i := range format.Placeholders {
+		if !fitted[i] {
+			format.precedence = append(format.precedence, i)
+		}
+	}
+	return format
+}
+
+// withSeparators returns a copy of the format whose separators went through transform.
+func (f *nameFormat) withSeparators(transform func(string) string) *nameFormat {
+	format := &nameFormat{
+		Placeholders: make([]formatPlaceholder, len(f.Placeholders)),
+		precedence:   f.precedence,
+	}
+	for i, placeholder := range f.Placeholders {
+		if placeholder.Separator != nil {
+			separator := transform(*placeholder.Separator)
+			placeholder.Separator = &separator
+		}
+		format.Placeholders[i] = placeholder
+	}
+	return format
+}
+
+// withSegmentRules returns a copy of the format where the placeholders listed in
+// required are no longer optional and whose fitting order follows the priorities.
+// Placeholders of the same priority keep the order of the precedence.
+func (f *nameFormat) withSegmentRules(priorities map[string]int, required []string) (*nameFormat, error) {
+	format := &nameFormat{
+		Placeholders: make([]formatPlaceholder, len(f.Placeholders)),
+		precedence:   append([]int{}, f.precedence...),
+	}
+	copy(format.Placeholders, f.Placeholders)
+	index := make(map[string]int, len(format.Placeholders))
+	for i, placeholder := range format.Placeholders {
+		index[placeholder.Key] = i
+	}
+
+	for key, priority := range priorities {
+		i, ok := index[key]
+		if !ok {
+			return nil, fmt.Errorf("segment_priorities sets the priority of {%s} which is not a placeholder of the format", key)
+		}
+		format.Placeholders[i].Priority = priority
+	}
+	for _, key := range required {
+		i, ok := index[key]
+		if !ok {
+			return nil, fmt.Errorf("required_segments lists {%s} which is not a placeholder of the format", key)
+		}
+		format.Placeholders[i].Optional = false
+	}
+
+	sort.SliceStable(format.precedence, func(i, j int) bool {
+		return format.Placeholders[format.precedence[i]].Priority > format.Placeholders[format.precedence[j]].Priority
+	})
+	return format, nil
+}
+
+// validateFormatValues checks that the format values do not shadow a built-in placeholder.
+func validateFormatValues(values map[string]string) error {
+	for key := range values {
+		for _, builtin := range builtinPlaceholders {
+			if key == builtin {
+				return fmt.Errorf("format_values must not set the built-in placeholder {%s}", key)
+			}
+		}
+		if !placeholderKeyRegEx.MatchString(key) {
+			return fmt.Errorf("invalid format_values key %q, keys must start with a lowercase letter followed by lowercase letters, digits or underscores", key)
+		}
+	}
+	return nil
+}
+
+// composeFormattedName builds a name from the values of the placeholders of a format.
+// Empty values are skipped. Names exceeding maxlength are first shortened with the
+// shortening strategy. The segments of the required placeholders are then always
+// included, the optional ones are included in the format precedence as long as the
+// name fits in maxlength, so the last ones are dropped first. Prefixes are fitted
+// from the last one, the other lists from the first one. It fails when the required
+// segments alone exceed maxlength.
+func composeFormattedName(format *nameFormat, values map[string][]string, separator string, maxlength int, shortening nameShortening, errorWhenExceedingMaxLength bool) (string, error) {
+	name, _, err := composeFormattedSegments(format, values, separator, maxlength, shortening, errorWhenExceedingMaxLength)
+	return name, err
+}
+
+// composeFormattedSegments builds a name like composeFormattedName and also returns
+// its segments, in the order of the format, telling which ones are part of the name.
+func composeFormattedSegments(format *nameFormat, values map[string][]string, separator string, maxlength int, shortening nameShortening, errorWhenExceedingMaxLength bool) (string, []NameSegment, error) {
+	nameBuilder := NewNameBuilder(maxlength, separator)
+
+	segments := make([][]int, len(format.Placeholders))
+	for i, placeholder := range format.Placeholders {
+		for _, value := range values[placeholder.Key] {
+			if len(value) == 0 {
+				continue
+			}
+			segments[i] = append(segments[i], nameBuilder.Add(NameSegment{Value: value, Separator: placeholder.Separator, Kind: placeholder.Key}))
+		}
+		if len(segments[i]) == 0 && !placeholder.Optional {
+			return "", nil, fmt.Errorf("format placeholder {%s} has no value, set it or mark the placeholder as optional with {%s?}", placeholder.Key, placeholder.Key)
+		}
+		if placeholder.Key == PlaceholderPrefixes {
+			for l, r := 0, len(segments[i])-1; l < r; l, r = l+1, r-1 {
+				segments[i][l], segments[i][r] = segments[i][r], segments[i][l]
+			}
+		}
+	}
+
+	// The segments get decreasing priorities in the order of the precedence
+	priority := len(nameBuilder.content)
+	for _, i := range format.precedence {
+		for _, segment := range segments[i] {
+			nameBuilder.content[segment].Priority = priority
+			nameBuilder.content[segment].PlaceholderPriority = format.Placeholders[i].Priority
+			nameBuilder.content[segment].Required = !format.Placeholders[i].Optional
+			priority--
+		}
+	}
+
+	// A name exceeding the maximum length is refused rather than shortened when
+	// error_when_exceeding_max_length is set
+	if !errorWhenExceedingMaxLength {
+		if shortened, ok := shortening.shorten(nameBuilder); ok {
+			return shortened, nameBuilder.content, nil
+		}
+	}
+	if err := nameBuilder.FitSegments(); err != nil {
+		return "", nil, err
+	}
+
+	if errorWhenExceedingMaxLength {
+		content := nameBuilder.GetName()
+		contentLength := len(content)
+		if contentLength > maxlength {
+			return "", nil, fmt.Errorf("composed name '%s' exceeds maximum length of %d by %d characters", content, maxlength, contentLength-maxlength)
+		}
+		return content, nameBuilder.content, nil
+	}
+	return nameBuilder.GetTrimmedName(), nameBuilder.content, nil
+}
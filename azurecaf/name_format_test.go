@@ -0,0 +1,213 @@
+package azurecaf
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestParseNameFormat(t *testing.T) {
+	format, err := parseNameFormat("{env}{slug}{workload}{region?}{instance?|}")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(format.Placeholders) != 5 {
+		t.Fatalf("expected 5 placeholders, got %d", len(format.Placeholders))
+	}
+	instance := format.Placeholders[4]
+	if instance.Key != "instance" || !instance.Optional || instance.Separator == nil || *instance.Separator != "" {
+		t.Errorf("unexpected instance placeholder %+v", instance)
+	}
+	if expected := []int{0, 1, 2, 3, 4}; len(format.precedence) != len(expected) {
+		t.Errorf("expected precedence %v, got %v", expected, format.precedence)
+	}
+
+	format, _ = parseNameFormat("{region?}{name}")
+	if format.precedence[0] != 1 {
+		t.Errorf("expected required placeholders to be fitted first, got %v", format.precedence)
+	}
+
+	invalid := map[string]string{
+		"text":         "app-{name}",
+		"unterminated": "{name",
+		"key":          "{Name}",
+		"duplicate":    "{name}{name?}",
+		"empty":        "",
+	}
+	for name, template := range invalid {
+		t.Run(name, func(t *testing.T) {
+			if _, err := parseNameFormat(template); err == nil {
+				t.Errorf("expected an error for %q", template)
+			}
+		})
+	}
+}
+
+func TestComposeFormattedName(t *testing.T) {
+	format, _ := parseNameFormat("{env}{slug}{workload}{region?}{instance?|}")
+	values := map[string][]string{
+		"env":      {"prd"},
+		"slug":     {"rg"},
+		"workload": {"billing"},
+		"region":   {"weu"},
+		"instance": {"01"},
+	}
+
+	cases := []struct {
+		name      string
+		maxLength int
+		expected  string
+	}{
+		{"fits", 30, "prd-rg-billing-weu01"},
+		{"drops the last optional segment", 18, "prd-rg-billing-weu"},
+		{"keeps the optional segments which fit", 16, "prd-rg-billing01"},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			name, err := composeFormattedName(format, values, "-", tt.maxLength, nameShortening{}, false)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if name != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, name)
+			}
+		})
+	}
+
+	t.Run("missing required value", func(t *testing.T) {
+		_, err := composeFormattedName(format, map[string][]string{"slug": {"rg"}}, "-", 30, nameShortening{}, false)
+		if err == nil || !strings.Contains(err.Error(), "{env}") {
+			t.Errorf("expected an error about {env}, got %v", err)
+		}
+	})
+
+	t.Run("required segments exceeding max length", func(t *testing.T) {
+		_, err := composeFormattedName(format, values, "-", 10, nameShortening{}, false)
+		if err == nil || !strings.Contains(err.Error(), "exceed the maximum length of 10") {
+			t.Errorf("expected an error about the required segments, got %v", err)
+		}
+	})
+
+	t.Run("error when exceeding max length", func(t *testing.T) {
+		if _, err := composeFormattedName(format, values, "-", 18, nameShortening{}, true); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func TestNameFormatWithSegmentRules(t *testing.T) {
+	values := map[string][]string{
+		PlaceholderPrefixes: {"prd"},
+		PlaceholderSlug:     {"rg"},
+		PlaceholderName:     {"billing"},
+		PlaceholderSuffixes: {"weu"},
+	}
+
+	format, err := legacyNameFormat(defaultNamePrecedence).withSegmentRules(map[string]int{PlaceholderPrefixes: 10}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if name, _ := composeFormattedName(format, values, "-", 11, nameShortening{}, false); name != "prd-billing" {
+		t.Errorf("expected the prefix to be kept ahead of the slug, got %s", name)
+	}
+
+	format, _ = legacyNameFormat(defaultNamePrecedence).withSegmentRules(nil, []string{PlaceholderSuffixes})
+	if name, _ := composeFormattedName(format, values, "-", 11, nameShortening{}, false); name != "billing-weu" {
+		t.Errorf("expected the required suffix to be kept, got %s", name)
+	}
+
+	if _, err := legacyNameFormat(defaultNamePrecedence).withSegmentRules(map[string]int{"env": 1}, nil); err == nil {
+		t.Error("expected an error for a priority of an unknown placeholder")
+	}
+	if _, err := legacyNameFormat(defaultNamePrecedence).withSegmentRules(nil, []string{"env"}); err == nil {
+		t.Error("expected an error for an unknown required placeholder")
+	}
+}
+
+func TestGetNameResult_Format(t *testing.T) {
+	cases := []struct {
+		name     string
+		raw      map[string]interface{}
+		expected string
+		err      string
+	}{
+		{
+			name: "custom layout",
+			raw: map[string]interface{}{
+				"name":          "billing",
+				"resource_type": "azurerm_resource_group",
+				"format":        "{env}{slug}{name}{region?}{instance?|}",
+				"format_values": map[string]interface{}{"env": "prd", "region": "weu", "instance": "01"},
+			},
+			expected: "prd-rg-billing-weu01",
+		},
+		{
+			name: "lowercase type without dashes",
+			raw: map[string]interface{}{
+				"name":          "billing",
+				"resource_type": "azurerm_storage_account",
+				"prefixes":      []interface{}{"contoso", "prd"},
+				"format":        "{slug}{name}{prefixes}",
+			},
+			expected: "stbillingcontosoprd",
+		},
+		{
+			name: "built-in placeholder in format_values",
+			raw: map[string]interface{}{
+				"name":          "billing",
+				"resource_type": "azurerm_resource_group",
+				"format":        "{slug}{name}",
+				"format_values": map[string]interface{}{"slug": "xx"},
+			},
+			err: "built-in placeholder {slug}",
+		},
+		{
+			name: "segment priorities",
+			raw: map[string]interface{}{
+				"name":               "billingservice",
+				"resource_type":      "azurerm_storage_account",
+				"prefixes":           []interface{}{"contosoprd"},
+				"segment_priorities": map[string]interface{}{"prefixes": 10},
+				"required_segments":  []interface{}{"name"},
+			},
+			expected: "contosoprdbillingservice",
+		},
+		{
+			name: "required segments exceeding max length",
+			raw: map[string]interface{}{
+				"name":              strings.Repeat("a", 100),
+				"resource_type":     "azurerm_resource_group",
+				"required_segments": []interface{}{"name"},
+			},
+			err: "exceed the maximum length of 90",
+		},
+		{
+			name: "missing value",
+			raw: map[string]interface{}{
+				"name":          "billing",
+				"resource_type": "azurerm_resource_group",
+				"format":        "{env}{name}",
+			},
+			err: "{env} has no value",
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceName().Schema, tt.raw)
+			_, err := getNameResult(d, nil)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("expected an error containing %q, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result := d.Get("result").(string); result != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, result)
+			}
+		})
+	}
+}
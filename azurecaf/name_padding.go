@@ -0,0 +1,38 @@
+package azurecaf
+
+import "fmt"
+
+// Padding of the names shorter than the minimum length of their resource type.
+const (
+	// PaddingHash pads the name with a hash of the name, the same name always gets the same padding
+	PaddingHash = "hash"
+	// PaddingRandom pads the name with random characters, seeded by random_seed
+	PaddingRandom = "random"
+	// PaddingNone leaves the name as it is, a name too short is refused
+	PaddingNone = "none"
+)
+
+var paddingModes = []string{PaddingHash, PaddingRandom, PaddingNone}
+
+// padName pads a name shorter than the minimum length of the resource type with the
+// lowercase letters and digits allowed by the resource type. It returns the name
+// unchanged when the padding is none, when no character is allowed or when the name
+// is empty, a name made of padding only is refused instead.
+func padName(resource *ResourceStructure, name string, padding string, seed int64) (string, []string) {
+	missing := resource.MinLength - len(name)
+	if missing <= 0 || padding == PaddingNone || name == "" {
+		return name, []string{}
+	}
+	alphabet := allowedCharacters(resource, hashgenerator)
+	if len(alphabet) == 0 {
+		return name, []string{}
+	}
+
+	if padding == PaddingRandom {
+		name += encodeRandomValues(randomValues(missing, &seed), alphabet)
+	} else {
+		padding = PaddingHash
+		name += hashWithAlphabet([]string{resource.ResourceTypeName, name}, missing, alphabet)
+	}
+	return name, []string{fmt.Sprintf("%s: padded with %d %s characters to the minimum length of %d", resource.ResourceTypeName, missing, padding, resource.MinLength)}
+}
@@ -0,0 +1,99 @@
+package azurecaf
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestPadName(t *testing.T) {
+	account := ResourceDefinitions["azurerm_automation_account"]
+
+	name, repairs := padName(&account, "ops", "", 0)
+	if len(name) != 6 || !strings.HasPrefix(name, "ops") || len(repairs) != 1 || repairs[0] != "azurerm_automation_account: padded with 3 hash characters to the minimum length of 6" {
+		t.Errorf("expected ops and 3 hash characters, got %s %v", name, repairs)
+	}
+	if again, _ := padName(&account, "ops", PaddingHash, 0); again != name {
+		t.Errorf("expected the hash padding to be deterministic, got %s and %s", name, again)
+	}
+
+	seeded, _ := padName(&account, "ops", PaddingRandom, 42)
+	if again, _ := padName(&account, "ops", PaddingRandom, 42); len(seeded) != 6 || again != seeded {
+		t.Errorf("expected the seeded random padding to be deterministic, got %s and %s", seeded, again)
+	}
+
+	if name, repairs := padName(&account, "ops", PaddingNone, 0); name != "ops" || len(repairs) != 0 {
+		t.Errorf("expected no padding, got %s %v", name, repairs)
+	}
+	if name, repairs := padName(&account, "", PaddingHash, 0); name != "" || len(repairs) != 0 {
+		t.Errorf("expected an empty name not to be padded, got %s %v", name, repairs)
+	}
+	if name, repairs := padName(&account, "operations", PaddingHash, 0); name != "operations" || len(repairs) != 0 {
+		t.Errorf("expected a long enough name to be kept, got %s %v", name, repairs)
+	}
+}
+
+func TestGenerateResourceName_MinLength(t *testing.T) {
+	account := ResourceDefinitions["azurerm_automation_account"]
+	inputs := nameInputs{Name: "ops", Separator: "-", CleanInput: true}
+
+	generated, err := generateResourceName(&account, inputs, "", ConventionCafClassic)
+	if err != nil || len(generated.Name) != 6 || len(generated.Repairs) != 1 {
+		t.Errorf("expected the name to be padded, got %s %v %v", generated.Name, generated.Repairs, err)
+	}
+
+	inputs.Padding = PaddingNone
+	if _, err := generateResourceName(&account, inputs, "", ConventionCafClassic); err == nil || !strings.HasSuffix(err.Error(), "ops is too short: 3 < 6") {
+		t.Errorf("expected the length in the error, got %v", err)
+	}
+
+	// Passthrough names are not padded
+	inputs = nameInputs{Name: "ops", Passthrough: true}
+	if _, err := generateResourceName(&account, inputs, "", ConventionCafClassic); err == nil || !strings.Contains(err.Error(), "too short: 3 < 6") {
+		t.Errorf("expected the passthrough name to be too short, got %v", err)
+	}
+
+	// The names matching the validation regex are kept, even shorter than the minimum length
+	probe := ResourceDefinitions["azurerm_lb_probe"]
+	for _, inputs := range []nameInputs{
+		{Name: "ab", Separator: "-", CleanInput: true},
+		{Name: "ab", Passthrough: true},
+	} {
+		generated, err := generateResourceName(&probe, inputs, "", ConventionCafClassic)
+		if err != nil || generated.Name != "ab" || len(generated.Repairs) != 0 {
+			t.Errorf("expected ab to be kept, got %s %v %v", generated.Name, generated.Repairs, err)
+		}
+	}
+}
+
+func TestResourceName_EmptyNameNotPadded(t *testing.T) {
+	nameResource := resourceName()
+	resourceData := schema.TestResourceDataRaw(t, nameResource.Schema, map[string]interface{}{
+		"name":          "",
+		"resource_type": "azurerm_resource_group",
+		"use_slug":      false,
+	})
+	err := diagnosticsError(nameResource.CreateContext(context.Background(), resourceData, nil))
+	if err == nil || !strings.HasSuffix(err.Error(), "the name is empty") {
+		t.Errorf("expected an error for the empty name, got %v and result %q", err, resourceData.Get("result"))
+	}
+}
+
+func TestDataName_Padding(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataName().Schema, map[string]interface{}{
+		"name":          "cfg",
+		"resource_type": "azurerm_app_configuration",
+		"use_slug":      false,
+	})
+	if diags := dataNameRead(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if result := d.Get("result").(string); len(result) != 5 || !strings.HasPrefix(result, "cfg") {
+		t.Errorf("expected cfg padded to 5 characters, got %s", result)
+	}
+	if repairs := d.Get("repairs").([]interface{}); len(repairs) != 1 {
+		t.Errorf("expected the padding to be reported, got %v", repairs)
+	}
+}
@@ -0,0 +1,162 @@
+package azurecaf
+
+import (
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// characterRule tells whether a character is allowed at one end of a name. A nil
+// rule allows any character.
+type characterRule func(r rune) bool
+
+// characterRules are the rules of the first and the last character of the names of
+// a resource type, derived from its validation regex.
+type characterRules struct {
+	Start characterRule
+	End   characterRule
+}
+
+var (
+	characterRulesCache = map[string]characterRules{}
+	characterRulesMutex sync.Mutex
+)
+
+// characterRulesFor derives the rules of the first and last characters of the names
+// from the validation regex of the resource type, e.g. a letter then a letter or a
+// digit for ^[a-z][a-z0-9-]{1,22}[a-z0-9]$. An end has no rule when the regex does
+// not constrain it in a way that can be derived, e.g. behind an alternation.
+func characterRulesFor(resource *ResourceStructure) characterRules {
+	characterRulesMutex.Lock()
+	defer characterRulesMutex.Unlock()
+	if rules, exists := characterRulesCache[resource.ValidationRegExp]; exists {
+		return rules
+	}
+	var rules characterRules
+	if re, err := syntax.Parse(resource.ValidationRegExp, syntax.Perl); err == nil {
+		rules.Start = edgeRule([]*syntax.Regexp{re}, false)
+		rules.End = edgeRule([]*syntax.Regexp{re}, true)
+	}
+	characterRulesCache[resource.ValidationRegExp] = rules
+	return rules
+}
+
+// neverRule is the rule of the end of the pattern, no character can follow it.
+func neverRule(rune) bool { return false }
+
+// edgeRule returns the rule of the first character matched by the sequence of
+// expressions, or of its last character when last is set.
+func edgeRule(sequence []*syntax.Regexp, last bool) characterRule {
+	if len(sequence) == 0 {
+		return neverRule
+	}
+	i := 0
+	if last {
+		i = len(sequence) - 1
+	}
+	re := sequence[i]
+	rest := append(append([]*syntax.Regexp{}, sequence[:i]...), sequence[i+1:]...)
+	// then returns the sequence where subs replace the current expression
+	then := func(subs ...*syntax.Regexp) []*syntax.Regexp {
+		if last {
+			return append(append([]*syntax.Regexp{}, rest...), subs...)
+		}
+		return append(append([]*syntax.Regexp{}, subs...), rest...)
+	}
+
+	switch re.Op {
+	case syntax.OpBeginLine, syntax.OpEndLine, syntax.OpBeginText, syntax.OpEndText, syntax.OpEmptyMatch:
+		return edgeRule(rest, last)
+	case syntax.OpLiteral:
+		edge := re.Rune[0]
+		if last {
+			edge = re.Rune[len(re.Rune)-1]
+		}
+		return matcherRule(&syntax.Regexp{Op: syntax.OpLiteral, Flags: re.Flags, Rune: []rune{edge}})
+	case syntax.OpCharClass, syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		return matcherRule(re)
+	case syntax.OpCapture, syntax.OpConcat:
+		return edgeRule(then(re.Sub...), last)
+	case syntax.OpPlus:
+		return edgeRule(then(re.Sub[0]), last)
+	case syntax.OpRepeat:
+		if re.Min > 0 {
+			return edgeRule(then(re.Sub[0]), last)
+		}
+		return unionRule(edgeRule(then(re.Sub[0]), last), edgeRule(rest, last))
+	case syntax.OpQuest, syntax.OpStar:
+		return unionRule(edgeRule(then(re.Sub[0]), last), edgeRule(rest, last))
+	}
+	return nil
+}
+
+// matcherRule returns the rule of a single character expression.
+func matcherRule(re *syntax.Regexp) characterRule {
+	matcher, err := regexp.Compile(`^(?:` + re.String() + `)$`)
+	if err != nil {
+		return nil
+	}
+	return func(r rune) bool { return matcher.MatchString(string(r)) }
+}
+
+// unionRule allows the characters allowed by either rule.
+func unionRule(a characterRule, b characterRule) characterRule {
+	if a == nil || b == nil {
+		return nil
+	}
+	return func(r rune) bool { return a(r) || b(r) }
+}
+
+// swapCase returns the character in the other case, or itself when it has no case.
+func swapCase(r rune) string {
+	if lower := strings.ToLower(string(r)); lower != string(r) {
+		return lower
+	}
+	return strings.ToUpper(string(r))
+}
+
+// repairName fixes the characters at the ends of a name which break the rules of
+// the resource type: an invalid first character is replaced with its other case
+// when that one is allowed, otherwise it is removed, as are the invalid last
+// characters. It returns the repaired name and the description of the changes.
+func repairName(resource *ResourceStructure, name string) (string, []string) {
+	rules := characterRulesFor(resource)
+	repairs := []string{}
+	for rules.Start != nil && name != "" {
+		first, size := utf8.DecodeRuneInString(name)
+		if rules.Start(first) {
+			break
+		}
+		if swapped := swapCase(first); swapped != string(first) && !resource.LowerCase && rules.Start([]rune(swapped)[0]) {
+			name = swapped + name[size:]
+			repairs = append(repairs, fmt.Sprintf("%s: replaced the leading %q with %q", resource.ResourceTypeName, string(first), swapped))
+			break
+		}
+		name = name[size:]
+		repairs = append(repairs, fmt.Sprintf("%s: removed the leading %q", resource.ResourceTypeName, string(first)))
+	}
+	for rules.End != nil && name != "" {
+		end, size := utf8.DecodeLastRuneInString(name)
+		if rules.End(end) {
+			break
+		}
+		name = name[:len(name)-size]
+		repairs = append(repairs, fmt.Sprintf("%s: removed the trailing %q", resource.ResourceTypeName, string(end)))
+	}
+	return name, repairs
+}
+
+// trimTrailingSeparators removes the separators left at the end of a truncated name,
+// e.g. by the truncation of its last segment, as long as the name does not match the
+// naming rules of the resource type.
+func trimTrailingSeparators(resource *ResourceStructure, validationRegEx *regexp.Regexp, name string, separator string) (string, []string) {
+	repairs := []string{}
+	for separator != "" && strings.HasSuffix(name, separator) && !validationRegEx.MatchString(name) {
+		name = strings.TrimSuffix(name, separator)
+		repairs = append(repairs, fmt.Sprintf("%s: removed the trailing separator %q", resource.ResourceTypeName, separator))
+	}
+	return name, repairs
+}
@@ -0,0 +1,157 @@
+package azurecaf
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestCharacterRulesFor(t *testing.T) {
+	cases := []struct {
+		pattern      string
+		start        string
+		notStart     string
+		end          string
+		notEnd       string
+		unconstraint bool
+	}{
+		{pattern: `^[a-z][a-z0-9-]{1,22}[a-z0-9]$`, start: "a", notStart: "1-", end: "a1", notEnd: "-"},
+		{pattern: `^[a-zA-Z0-9]([a-zA-Z0-9-]{0,88}[a-zA-Z0-9])?$`, start: "aA1", notStart: "-", end: "aA1", notEnd: "-"},
+		{pattern: `^[a-zA-Z0-9-._\(\)]{0,89}[a-zA-Z0-9-_\(\)]$`, start: "a-.", notStart: "!", end: "a-)", notEnd: "."},
+		{pattern: `^[a-z0-9]{3,24}$`, start: "a1", notStart: "-", end: "a1", notEnd: "-"},
+		{pattern: `^(ab|cd)[a-z]{1,5}$`, end: "a", notEnd: "1", unconstraint: true},
+	}
+	for _, tt := range cases {
+		rules := characterRulesFor(&ResourceStructure{ValidationRegExp: tt.pattern})
+		if tt.unconstraint {
+			if rules.Start != nil {
+				t.Errorf("%s: expected no start rule behind an alternation", tt.pattern)
+			}
+		} else if rules.Start == nil {
+			t.Fatalf("%s: expected a start rule", tt.pattern)
+		}
+		if rules.End == nil {
+			t.Fatalf("%s: expected an end rule", tt.pattern)
+		}
+		for _, r := range tt.start {
+			if !rules.Start(r) {
+				t.Errorf("%s: expected %q to be allowed first", tt.pattern, r)
+			}
+		}
+		for _, r := range tt.notStart {
+			if rules.Start(r) {
+				t.Errorf("%s: expected %q not to be allowed first", tt.pattern, r)
+			}
+		}
+		for _, r := range tt.end {
+			if !rules.End(r) {
+				t.Errorf("%s: expected %q to be allowed last", tt.pattern, r)
+			}
+		}
+		for _, r := range tt.notEnd {
+			if rules.End(r) {
+				t.Errorf("%s: expected %q not to be allowed last", tt.pattern, r)
+			}
+		}
+	}
+
+	// Every built-in validation regex gives rules for both ends
+	for resourceType, resource := range ResourceDefinitions {
+		if rules := characterRulesFor(&resource); rules.End == nil {
+			t.Errorf("%s: no end rule derived from %s", resourceType, resource.ValidationRegExp)
+		}
+	}
+}
+
+func TestRepairName(t *testing.T) {
+	lower := &ResourceStructure{ResourceTypeName: "contoso_widget", LowerCase: true, ValidationRegExp: `^[a-z][a-z0-9-]{1,22}[a-z0-9]$`}
+	name, repairs := repairName(lower, "1-widget--")
+	if name != "widget" {
+		t.Errorf("expected widget, got %s", name)
+	}
+	expected := []string{
+		`contoso_widget: removed the leading "1"`,
+		`contoso_widget: removed the leading "-"`,
+		`contoso_widget: removed the trailing "-"`,
+		`contoso_widget: removed the trailing "-"`,
+	}
+	if !reflect.DeepEqual(repairs, expected) {
+		t.Errorf("expected %v, got %v", expected, repairs)
+	}
+
+	upper := &ResourceStructure{ResourceTypeName: "contoso_gadget", ValidationRegExp: `^[A-Z][a-zA-Z0-9]{1,10}$`}
+	if name, repairs := repairName(upper, "gadget"); name != "Gadget" || !reflect.DeepEqual(repairs, []string{`contoso_gadget: replaced the leading "g" with "G"`}) {
+		t.Errorf("expected the first letter to be capitalized, got %s %v", name, repairs)
+	}
+}
+
+func TestGenerateResourceName_Repairs(t *testing.T) {
+	vault := ResourceDefinitions["azurerm_key_vault"]
+	generated, err := generateResourceName(&vault, nameInputs{Name: "-1vault-", Separator: "-", CleanInput: true}, "", ConventionCafClassic)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if generated.Name != "vault" || len(generated.Repairs) != 3 {
+		t.Errorf("expected vault after 3 repairs, got %s %v", generated.Name, generated.Repairs)
+	}
+
+	// The separator left at the end by the truncation is removed when the rules refuse it
+	widget := &ResourceStructure{ResourceTypeName: "contoso_widget", MinLength: 1, MaxLength: 8, RegEx: alphanumh, ValidationRegExp: `^[a-z0-9-]{0,7}[a-z0-9]$`, LowerCase: true}
+	inputs := nameInputs{Name: "widget", Suffixes: []string{"we-eu"}, Separator: "-", CleanInput: true, Shortening: ShorteningProportional}
+	generated, err = generateResourceName(widget, inputs, "", ConventionCafClassic)
+	if err != nil || generated.Name != "widg-we" || !reflect.DeepEqual(generated.Repairs, []string{`contoso_widget: removed the trailing separator "-"`}) {
+		t.Errorf("expected the separator left by the truncation to be removed, got %s %v %v", generated.Name, generated.Repairs, err)
+	}
+	widget.ValidationRegExp = `^[a-z0-9-]{1,8}$`
+	generated, err = generateResourceName(widget, inputs, "", ConventionCafClassic)
+	if err != nil || generated.Name != "widg-we-" || len(generated.Repairs) != 0 {
+		t.Errorf("expected the valid name to be kept, got %s %v %v", generated.Name, generated.Repairs, err)
+	}
+
+	// The names which are not truncated keep their trailing separators and content
+	group := ResourceDefinitions["azurerm_resource_group"]
+	generated, err = generateResourceName(&group, nameInputs{Name: "my-", Separator: "-"}, "", ConventionCafClassic)
+	if err != nil || generated.Name != "my-" || len(generated.Repairs) != 0 {
+		t.Errorf("expected my- to be kept, got %s %v %v", generated.Name, generated.Repairs, err)
+	}
+	generated, err = generateResourceName(&group, nameInputs{Name: "foo", Suffixes: []string{"x"}, Separator: "x", UseSlug: true}, "", ConventionCafClassic)
+	if err != nil || generated.Name != "rgxfooxx" || len(generated.Repairs) != 0 {
+		t.Errorf("expected rgxfooxx to be kept, got %s %v %v", generated.Name, generated.Repairs, err)
+	}
+
+	// Passthrough names are only validated
+	if _, err := generateResourceName(&vault, nameInputs{Name: "1vault", Passthrough: true}, "", ConventionCafClassic); err == nil {
+		t.Error("expected the passthrough name to be refused")
+	}
+}
+
+func TestResourceName_Repairs(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceName().Schema, map[string]interface{}{
+		"name":          "1vault",
+		"resource_type": "azurerm_key_vault",
+		"use_slug":      false,
+	})
+	if _, err := getNameResult(d, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result := d.Get("result").(string); result != "vault" {
+		t.Errorf("expected vault, got %s", result)
+	}
+	if repairs := convertInterfaceToString(d.Get("repairs").([]interface{})); !reflect.DeepEqual(repairs, []string{`azurerm_key_vault: removed the leading "1"`}) {
+		t.Errorf("unexpected repairs %v", repairs)
+	}
+
+	d = schema.TestResourceDataRaw(t, dataName().Schema, map[string]interface{}{
+		"name":          "1vault",
+		"resource_type": "azurerm_key_vault",
+		"use_slug":      false,
+	})
+	if diags := dataNameRead(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if repairs := d.Get("repairs").([]interface{}); d.Get("result").(string) != "vault" || len(repairs) != 1 {
+		t.Errorf("expected vault after one repair, got %s %v", d.Get("result"), repairs)
+	}
+}
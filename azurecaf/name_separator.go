@@ -0,0 +1,67 @@
+package azurecaf
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// separatorFor returns the separator of the names of a resource type: the one set
+// for the resource type in inputs.Separators, otherwise inputs.Separator, replaced
+// with the fallback separator of the resource type when inputs.AdaptSeparator is
+// set and the resource type does not allow it.
+func separatorFor(resource *ResourceStructure, inputs nameInputs) string {
+	if separator, exists := inputs.Separators[resource.ResourceTypeName]; exists {
+		return separator
+	}
+	if !inputs.AdaptSeparator || cleanString(inputs.Separator, resource) == inputs.Separator {
+		return inputs.Separator
+	}
+	return fallbackSeparator(resource)
+}
+
+// fallbackSeparator returns the separator of the names of a resource type which does
+// not allow the configured one: a dash when the resource type allows dashes, an
+// underscore when its regex allows it, none otherwise.
+func fallbackSeparator(resource *ResourceStructure) string {
+	if resource.Dashes && cleanString("-", resource) == "-" {
+		return "-"
+	}
+	if cleanString("_", resource) == "_" {
+		return "_"
+	}
+	return ""
+}
+
+// resolveSeparators returns the separators keyed by the name of their resource type.
+// The keys may be any form of resource type accepted by getResource, and must be
+// among the resource types of the names.
+func (s resourceDefinitionSet) resolveSeparators(separators map[string]string, resourceTypes []string) (map[string]string, error) {
+	names := []string{}
+	for _, resourceType := range resourceTypes {
+		if resource, err := s.getResource(resourceType); err == nil {
+			names = append(names, resource.ResourceTypeName)
+		}
+	}
+	keys := make([]string, 0, len(separators))
+	for key := range separators {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	resolved := make(map[string]string, len(separators))
+	for _, key := range keys {
+		resource, err := s.getResource(key)
+		if err != nil {
+			return nil, fmt.Errorf("separators: %w", err)
+		}
+		if !slices.Contains(names, resource.ResourceTypeName) {
+			return nil, fmt.Errorf("separators: %s is neither resource_type nor one of resource_types", key)
+		}
+		if _, exists := resolved[resource.ResourceTypeName]; exists {
+			return nil, fmt.Errorf("separators: %s is set more than once", resource.ResourceTypeName)
+		}
+		resolved[resource.ResourceTypeName] = separators[key]
+	}
+	return resolved, nil
+}
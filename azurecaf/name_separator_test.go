@@ -0,0 +1,125 @@
+package azurecaf
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestFallbackSeparator(t *testing.T) {
+	cases := map[string]string{
+		"azurerm_resource_group":   "-",
+		"azurerm_storage_account":  "",
+		"aks_node_pool_linux":      "",
+		"azurerm_synapse_sql_pool": "_",
+		// Dashes are set, but the regex does not allow them
+		"azurerm_automation_runbook": "_",
+	}
+	for resourceType, expected := range cases {
+		resource := ResourceDefinitions[resourceType]
+		if separator := fallbackSeparator(&resource); separator != expected {
+			t.Errorf("%s: expected %q, got %q", resourceType, expected, separator)
+		}
+	}
+}
+
+func TestSeparatorFor(t *testing.T) {
+	storage := ResourceDefinitions["azurerm_storage_account"]
+	pool := ResourceDefinitions["azurerm_synapse_sql_pool"]
+	group := ResourceDefinitions["azurerm_resource_group"]
+
+	inputs := nameInputs{Separator: "-"}
+	if separator := separatorFor(&pool, inputs); separator != "-" {
+		t.Errorf("expected the separator to be kept without adapt_separator, got %q", separator)
+	}
+	inputs.AdaptSeparator = true
+	if separator := separatorFor(&pool, inputs); separator != "_" {
+		t.Errorf("expected the fallback separator, got %q", separator)
+	}
+	if separator := separatorFor(&group, inputs); separator != "-" {
+		t.Errorf("expected the allowed separator to be kept, got %q", separator)
+	}
+	inputs.Separators = map[string]string{"azurerm_storage_account": "x"}
+	if separator := separatorFor(&storage, inputs); separator != "x" {
+		t.Errorf("expected the explicit separator, got %q", separator)
+	}
+}
+
+func TestResolveSeparators(t *testing.T) {
+	resourceTypes := []string{"azurerm_resource_group", "azurerm_storage_account"}
+	resolved, err := builtinDefinitionSet.resolveSeparators(map[string]string{"st": "", "azurerm_resource_group": "_"}, resourceTypes)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := map[string]string{"azurerm_storage_account": "", "azurerm_resource_group": "_"}; !reflect.DeepEqual(resolved, expected) {
+		t.Errorf("expected %v, got %v", expected, resolved)
+	}
+
+	if _, err := builtinDefinitionSet.resolveSeparators(map[string]string{"azurerm_key_vault": ""}, resourceTypes); err == nil || !strings.Contains(err.Error(), "azurerm_key_vault is neither resource_type nor one of resource_types") {
+		t.Errorf("expected the resource types of the names to be enforced, got %v", err)
+	}
+	if _, err := builtinDefinitionSet.resolveSeparators(map[string]string{"st": "", "azurerm_storage_account": "_"}, resourceTypes); err == nil || !strings.Contains(err.Error(), "set more than once") {
+		t.Errorf("expected the duplicated resource type to fail, got %v", err)
+	}
+}
+
+func TestResourceName_Separators(t *testing.T) {
+	raw := map[string]interface{}{
+		"name":            "my app",
+		"resource_type":   "azurerm_resource_group",
+		"resource_types":  []interface{}{"azurerm_synapse_sql_pool", "azurerm_storage_account"},
+		"prefixes":        []interface{}{"dev"},
+		"adapt_separator": true,
+	}
+	d := schema.TestResourceDataRaw(t, resourceName().Schema, raw)
+	if _, err := getNameResult(d, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string]interface{}{"azurerm_synapse_sql_pool": "dev_synsp_myapp", "azurerm_storage_account": "devstmyapp"}
+	if d.Get("result").(string) != "dev-rg-myapp" || !reflect.DeepEqual(d.Get("results"), expected) {
+		t.Errorf("expected the fallback separators, got %s %v", d.Get("result"), d.Get("results"))
+	}
+
+	raw["separators"] = map[string]interface{}{"azurerm_synapse_sql_pool": ""}
+	d = schema.TestResourceDataRaw(t, resourceName().Schema, raw)
+	if _, err := getNameResult(d, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if name := d.Get("results").(map[string]interface{})["azurerm_synapse_sql_pool"]; name != "devsynspmyapp" {
+		t.Errorf("expected the explicit separator, got %v", name)
+	}
+}
+
+func TestDataName_Separators(t *testing.T) {
+	raw := map[string]interface{}{
+		"name":            "my app",
+		"resource_type":   "azurerm_synapse_sql_pool",
+		"prefixes":        []interface{}{"dev"},
+		"adapt_separator": true,
+	}
+	d := schema.TestResourceDataRaw(t, dataName().Schema, raw)
+	if diags := dataNameRead(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if result := d.Get("result").(string); result != "dev_synsp_myapp" {
+		t.Errorf("expected the fallback separator, got %s", result)
+	}
+
+	raw["separators"] = map[string]interface{}{"azurerm_synapse_sql_pool": ""}
+	d = schema.TestResourceDataRaw(t, dataName().Schema, raw)
+	if diags := dataNameRead(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if result := d.Get("result").(string); result != "devsynspmyapp" {
+		t.Errorf("expected the explicit separator, got %s", result)
+	}
+
+	raw["separators"] = map[string]interface{}{"azurerm_storage_account": ""}
+	d = schema.TestResourceDataRaw(t, dataName().Schema, raw)
+	if diags := dataNameRead(context.Background(), d, nil); !diags.HasError() {
+		t.Error("expected the separator of another resource type to be refused")
+	}
+}
@@ -0,0 +1,183 @@
+package azurecaf
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Strategies shortening the names exceeding the maximum length of a resource type.
+const (
+	// ShorteningTruncate drops the segments which do not fit and truncates the name, the historical behavior
+	ShorteningTruncate = "truncate"
+	// ShorteningProportional truncates the name, prefixes, suffixes and custom segments proportionally to their length
+	ShorteningProportional = "proportional"
+	// ShorteningVowels removes the vowels of the name segment, from its end
+	ShorteningVowels = "vowels"
+	// ShorteningHash replaces the overflowing characters with a short hash of the whole name
+	ShorteningHash = "hash"
+)
+
+var shorteningModes = []string{ShorteningTruncate, ShorteningProportional, ShorteningVowels, ShorteningHash}
+
+// shorteningHashLength is the length of the hash replacing the overflow in hash mode.
+const shorteningHashLength = 5
+
+// nameShortening shortens the segments of a name exceeding the maximum length
+// before they are fitted in the name.
+type nameShortening struct {
+	Mode string
+	// Alphabet holds the characters of the hash replacing the overflow in hash mode
+	Alphabet []rune
+}
+
+// shorten applies the strategy to the segments of the builder. It returns the final
+// name when the strategy builds it on its own, as the hash strategy does, otherwise
+// the shortened segments are fitted by the caller.
+func (s nameShortening) shorten(b *NameBuilder) (string, bool) {
+	overflow := len(b.GetName()) - b.MaxLength
+	if overflow <= 0 {
+		return "", false
+	}
+	switch s.Mode {
+	case ShorteningProportional:
+		b.shortenProportionally(overflow)
+	case ShorteningVowels:
+		b.removeVowels(overflow)
+	case ShorteningHash:
+		if b.MaxLength <= 2*shorteningHashLength || len(s.Alphabet) == 0 {
+			return "", false
+		}
+		hash := hashWithAlphabet([]string{b.GetName()}, shorteningHashLength, s.Alphabet)
+		if !b.cutOptionalSegments(b.MaxLength - shorteningHashLength) {
+			return "", false
+		}
+		return b.GetTrimmedName() + hash, true
+	}
+	return "", false
+}
+
+// cutOptionalSegments shortens the name to length characters by cutting the segments
+// which are not required, from the end of the name, the segments of the lowest
+// placeholder priority first. A segment cut to nothing is left out with its separator.
+// The segments are left unchanged when the required ones alone exceed length.
+func (b *NameBuilder) cutOptionalSegments(length int) bool {
+	content := append([]NameSegment{}, b.content...)
+	order := []int{}
+	for i := len(b.content) - 1; i >= 0; i-- {
+		b.content[i].Include = true
+		if !b.content[i].Required {
+			order = append(order, i)
+		}
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return b.content[order[i]].PlaceholderPriority < b.content[order[j]].PlaceholderPriority
+	})
+	for _, i := range order {
+		excess := len(b.GetTrimmedName()) - length
+		if excess <= 0 {
+			break
+		}
+		if len(b.content[i].Value) <= excess {
+			b.content[i].Include = false
+		} else {
+			b.content[i].Value = truncateString(b.content[i].Value, len(b.content[i].Value)-excess)
+		}
+	}
+	if len(b.GetTrimmedName()) > length {
+		b.content = content
+		return false
+	}
+	return true
+}
+
+// shortenable reports whether a segment may be shortened, the slug, random and hash
+// segments are kept whole.
+func shortenable(segment NameSegment) bool {
+	return segment.Kind != PlaceholderSlug && segment.Kind != PlaceholderRandom && segment.Kind != PlaceholderHash
+}
+
+// shortenProportionally shortens the shortenable segments by overflow characters in
+// total, each one proportionally to its length. Segments keep at least one character.
+func (b *NameBuilder) shortenProportionally(overflow int) {
+	indexes := []int{}
+	total := 0
+	for i, segment := range b.content {
+		if shortenable(segment) {
+			indexes = append(indexes, i)
+			total += len(segment.Value)
+		}
+	}
+	target := total - overflow
+	if target < len(indexes) {
+		target = len(indexes)
+	}
+	if len(indexes) == 0 || target >= total {
+		return
+	}
+
+	lengths := make([]int, len(indexes))
+	remainders := make([]int, len(indexes))
+	allocated := 0
+	for j, i := range indexes {
+		length := len(b.content[i].Value)
+		lengths[j] = length * target / total
+		remainders[j] = length * target % total
+		if lengths[j] < 1 {
+			lengths[j] = 1
+			remainders[j] = 0
+		}
+		allocated += lengths[j]
+	}
+
+	// The characters left are given to the segments with the largest remainders,
+	// the first ones on ties, so that the result is deterministic
+	order := make([]int, len(indexes))
+	for j := range order {
+		order[j] = j
+	}
+	sort.SliceStable(order, func(x, y int) bool { return remainders[order[x]] > remainders[order[y]] })
+	for _, j := range order {
+		if allocated >= target {
+			break
+		}
+		if lengths[j] < len(b.content[indexes[j]].Value) {
+			lengths[j]++
+			allocated++
+		}
+	}
+
+	for j, i := range indexes {
+		b.content[i].Value = truncateString(b.content[i].Value, lengths[j])
+	}
+}
+
+// removeVowels removes up to overflow vowels from the name segments, starting from
+// their end. The first character of a segment is always kept.
+func (b *NameBuilder) removeVowels(overflow int) {
+	for i := len(b.content) - 1; i >= 0 && overflow > 0; i-- {
+		if b.content[i].Kind != PlaceholderName {
+			continue
+		}
+		value := []rune(b.content[i].Value)
+		for j := len(value) - 1; j > 0 && overflow > 0; j-- {
+			if strings.ContainsRune("aeiouAEIOU", value[j]) {
+				value = append(value[:j], value[j+1:]...)
+				overflow--
+			}
+		}
+		b.content[i].Value = string(value)
+	}
+}
+
+// truncateString returns the longest prefix of value of at most length bytes which
+// does not split a character.
+func truncateString(value string, length int) string {
+	if len(value) <= length {
+		return value
+	}
+	for length > 0 && !utf8.RuneStart(value[length]) {
+		length--
+	}
+	return value[:length]
+}
@@ -0,0 +1,141 @@
+package azurecaf
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestGetNameResult_Shortening(t *testing.T) {
+	cases := []struct {
+		mode     string
+		expected string
+	}{
+		{"", "myverylongworkloadname"},
+		{ShorteningTruncate, "myverylongworkloadname"},
+		{ShorteningProportional, "pr-kv-myverylongworkl-we"},
+		{ShorteningVowels, "kv-myvrylngwrkldnm-weu"},
+		{ShorteningHash, "prd-kv-myverylongwogsv8g"},
+	}
+	for _, tt := range cases {
+		t.Run(tt.mode, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"name":          "myverylongworkloadname",
+				"resource_type": "azurerm_key_vault",
+				"prefixes":      []interface{}{"prd"},
+				"suffixes":      []interface{}{"weu"},
+				"shortening":    tt.mode,
+			}
+			d := schema.TestResourceDataRaw(t, resourceName().Schema, raw)
+			if _, err := getNameResult(d, nil); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			result := d.Get("result").(string)
+			if len(result) > 24 {
+				t.Errorf("expected at most 24 characters, got %s", result)
+			}
+			if !regexp.MustCompile(ResourceDefinitions["azurerm_key_vault"].ValidationRegExp).MatchString(result) {
+				t.Errorf("expected %s to be a valid key vault name", result)
+			}
+			if tt.expected != "" && result != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, result)
+			}
+
+			d = schema.TestResourceDataRaw(t, resourceName().Schema, raw)
+			if _, err := getNameResult(d, nil); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if again := d.Get("result").(string); again != result {
+				t.Errorf("expected a deterministic name, got %s and %s", result, again)
+			}
+		})
+	}
+}
+
+func TestGetNameResult_ShorteningErrorWhenExceedingMaxLength(t *testing.T) {
+	for _, mode := range shorteningModes {
+		t.Run(mode, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceName().Schema, map[string]interface{}{
+				"name":                            "myverylongworkloadname",
+				"resource_type":                   "azurerm_key_vault",
+				"prefixes":                        []interface{}{"prd"},
+				"suffixes":                        []interface{}{"weu"},
+				"shortening":                      mode,
+				"error_when_exceeding_max_length": true,
+			})
+			if _, err := getNameResult(d, nil); err == nil || !strings.Contains(err.Error(), "exceeds maximum length of 24") {
+				t.Errorf("expected the maximum length error, got %v and result %s", err, d.Get("result"))
+			}
+		})
+	}
+}
+
+func TestGetNameResult_HashShorteningSegmentRules(t *testing.T) {
+	cases := []struct {
+		name   string
+		rules  map[string]interface{}
+		prefix string
+	}{
+		{"required suffix", map[string]interface{}{"required_segments": []interface{}{"suffixes"}}, "prd-kv-myverylo-weu"},
+		{"name priority", map[string]interface{}{"segment_priorities": map[string]interface{}{"name": 10}}, "myverylongworkloadn"},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"name":          "myverylongworkloadname",
+				"resource_type": "azurerm_key_vault",
+				"prefixes":      []interface{}{"prd"},
+				"suffixes":      []interface{}{"weu"},
+				"shortening":    ShorteningHash,
+			}
+			for k, v := range tt.rules {
+				raw[k] = v
+			}
+			d := schema.TestResourceDataRaw(t, resourceName().Schema, raw)
+			if _, err := getNameResult(d, nil); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result := d.Get("result").(string); len(result) != 24 || !strings.HasPrefix(result, tt.prefix) {
+				t.Errorf("expected %s followed by the hash, got %s", tt.prefix, result)
+			}
+		})
+	}
+
+	// Required segments which do not fit beside the hash are fitted without it
+	format, _ := parseNameFormat("{prefixes}{name?}")
+	values := map[string][]string{PlaceholderPrefixes: {"contoso"}, PlaceholderName: {"billing"}}
+	shortening := nameShortening{Mode: ShorteningHash, Alphabet: hashgenerator}
+	if name, err := composeFormattedName(format, values, "-", 11, shortening, false); err != nil || name != "contoso" {
+		t.Errorf("expected contoso, got %s %v", name, err)
+	}
+	if _, err := composeFormattedName(format, values, "-", 6, shortening, false); err == nil || !strings.Contains(err.Error(), "required segments 'contoso'") {
+		t.Errorf("expected the required segments error, got %v", err)
+	}
+}
+
+func TestShortenProportionally(t *testing.T) {
+	b := NewNameBuilder(20, "-")
+	for _, segment := range []NameSegment{
+		{Value: "contoso", Kind: PlaceholderPrefixes},
+		{Value: "rg", Kind: PlaceholderSlug},
+		{Value: "billingplatform", Kind: PlaceholderName},
+		{Value: "abcde", Kind: PlaceholderRandom},
+	} {
+		b.Add(segment)
+	}
+	b.shortenProportionally(len(b.GetName()) - 20)
+	if name := b.GetName(); name != "con-rg-billing-abcde" {
+		t.Errorf("expected con-rg-billing-abcde, got %s", name)
+	}
+}
+
+func TestTruncateString(t *testing.T) {
+	if truncated := truncateString("héllo", 2); truncated != "h" {
+		t.Errorf("expected the multi-byte character to be dropped, got %q", truncated)
+	}
+	if truncated := truncateString("hello", 10); truncated != "hello" {
+		t.Errorf("expected the value to be kept, got %q", truncated)
+	}
+}
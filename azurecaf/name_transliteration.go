@@ -0,0 +1,109 @@
+package azurecaf
+
+import (
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// transliterations are the letters which do not decompose into a base letter and
+// diacritics, with their ASCII spelling.
+var transliterations = map[rune]string{
+	'ß': "ss", 'ẞ': "SS",
+	'æ': "ae", 'Æ': "AE",
+	'œ': "oe", 'Œ': "OE",
+	'ø': "o", 'Ø': "O",
+	'đ': "d", 'Đ': "D",
+	'ð': "d", 'Ð': "D",
+	'ł': "l", 'Ł': "L",
+	'þ': "th", 'Þ': "TH",
+	'ı': "i",
+	'ŋ': "n", 'Ŋ': "N",
+}
+
+// isCombiningMark reports whether r belongs to the blocks of the combining
+// diacritical marks, e.g. the acute accent left by the decomposition of é.
+func isCombiningMark(r rune) bool {
+	return (r >= 0x0300 && r <= 0x036F) ||
+		(r >= 0x1AB0 && r <= 0x1AFF) ||
+		(r >= 0x1DC0 && r <= 0x1DFF) ||
+		(r >= 0x20D0 && r <= 0x20FF) ||
+		(r >= 0xFE20 && r <= 0xFE2F)
+}
+
+// transliterate spells the letters of value with ASCII characters when possible:
+// the compatibility characters are normalized (ﬁ→fi), the diacritics are removed
+// (é→e) and the letters listed in transliterations are replaced (ß→ss, ø→o). The
+// other characters are kept, to be cleaned by the naming rules of the resource type.
+func transliterate(value string) string {
+	var b strings.Builder
+	for _, r := range norm.NFKD.String(value) {
+		if isCombiningMark(r) {
+			continue
+		}
+		if replacement, exists := transliterations[r]; exists {
+			b.WriteString(replacement)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return norm.NFC.String(b.String())
+}
+
+// transliterateSlice applies transliterate to every value, in a new slice.
+func transliterateSlice(values []string) []string {
+	transliterated := make([]string, len(values))
+	for i, value := range values {
+		transliterated[i] = transliterate(value)
+	}
+	return transliterated
+}
+
+// cleanedCharacters returns the characters of the inputs replaced or removed by the
+// cleaning of the inputs for the resource type, mapped to their replacement, empty
+// when they are removed. The inputs are the name, prefixes, suffixes, separator and
+// custom values, the name alone for a passthrough name.
+func cleanedCharacters(inputs nameInputs, resource *ResourceStructure) map[string]string {
+	cleaned := map[string]string{}
+	if !inputs.CleanInput {
+		return cleaned
+	}
+	values := []string{inputs.Name}
+	if !inputs.Passthrough {
+		values = append(values, separatorFor(resource, inputs))
+		values = append(values, inputs.Prefixes...)
+		values = append(values, inputs.Suffixes...)
+		for _, value := range inputs.FormatValues {
+			values = append(values, value)
+		}
+	}
+	for _, value := range values {
+		for _, r := range value {
+			character := string(r)
+			if _, exists := cleaned[character]; exists {
+				continue
+			}
+			replacement := character
+			if inputs.Transliterate {
+				replacement = transliterate(replacement)
+			}
+			if replacement = cleanString(replacement, resource); replacement != character {
+				cleaned[character] = replacement
+			}
+		}
+	}
+	return cleaned
+}
+
+// flattenCleanedCharacters returns the characters cleaned from the inputs of a
+// resource type in the shape of the cleaned_characters attribute of azurecaf_name.
+func flattenCleanedCharacters(resourceType string, cleaned map[string]string) map[string]interface{} {
+	characters := make(map[string]interface{}, len(cleaned))
+	for character, replacement := range cleaned {
+		characters[character] = replacement
+	}
+	return map[string]interface{}{
+		"resource_type": resourceType,
+		"characters":    characters,
+	}
+}
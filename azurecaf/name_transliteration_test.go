@@ -0,0 +1,140 @@
+package azurecaf
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestTransliterate(t *testing.T) {
+	cases := map[string]string{
+		"Société-Générale": "Societe-Generale",
+		"Straße":           "Strasse",
+		"Ørsted":           "Orsted",
+		"Łódź":             "Lodz",
+		"ﬁnance":           "finance",
+		"Café":            "Cafe",
+		"東京":               "東京",
+		"my-app_01":        "my-app_01",
+	}
+	for value, expected := range cases {
+		if transliterated := transliterate(value); transliterated != expected {
+			t.Errorf("%q: expected %q, got %q", value, expected, transliterated)
+		}
+	}
+}
+
+func TestGetResourceNameForDefinition_Transliterate(t *testing.T) {
+	group := ResourceDefinitions["azurerm_resource_group"]
+	inputs := nameInputs{Name: "Société-Générale", Prefixes: []string{"Straße"}, Separator: "-", CleanInput: true, UseSlug: true}
+
+	name, err := getResourceNameForDefinition(&group, inputs, "", ConventionCafClassic)
+	if err != nil || name != "Strae-rg-Socit-Gnrale" {
+		t.Errorf("expected the non-ASCII letters to be removed, got %s, %v", name, err)
+	}
+
+	inputs.Transliterate = true
+	name, err = getResourceNameForDefinition(&group, inputs, "", ConventionCafClassic)
+	if err != nil || name != "Strasse-rg-Societe-Generale" {
+		t.Errorf("expected the non-ASCII letters to be transliterated, got %s, %v", name, err)
+	}
+
+	inputs.CleanInput = false
+	if _, err := getResourceNameForDefinition(&group, inputs, "", ConventionCafClassic); err == nil {
+		t.Error("expected the inputs to be kept, and refused, without clean_input")
+	}
+}
+
+func TestCleanedCharacters(t *testing.T) {
+	storage := ResourceDefinitions["azurerm_storage_account"]
+	group := ResourceDefinitions["azurerm_resource_group"]
+	inputs := nameInputs{Name: "Société Générale", Separator: "-", CleanInput: true, Transliterate: true}
+
+	cleaned := cleanedCharacters(inputs, &group)
+	if expected := map[string]string{"é": "e", " ": ""}; !reflect.DeepEqual(cleaned, expected) {
+		t.Errorf("expected %v, got %v", expected, cleaned)
+	}
+	cleaned = cleanedCharacters(inputs, &storage)
+	if expected := map[string]string{"é": "e", " ": "", "-": "", "S": "", "G": ""}; !reflect.DeepEqual(cleaned, expected) {
+		t.Errorf("expected %v, got %v", expected, cleaned)
+	}
+
+	// Passthrough names are cleaned too, the other inputs are not used
+	passthrough := nameInputs{Name: "Société Générale", Prefixes: []string{"a_b"}, Separator: "_", CleanInput: true, Passthrough: true}
+	if expected := map[string]string{"é": "", " ": ""}; !reflect.DeepEqual(cleanedCharacters(passthrough, &group), expected) {
+		t.Errorf("expected %v, got %v", expected, cleanedCharacters(passthrough, &group))
+	}
+
+	inputs.CleanInput = false
+	if cleaned := cleanedCharacters(inputs, &group); len(cleaned) != 0 {
+		t.Errorf("expected no cleaned character without clean_input, got %v", cleaned)
+	}
+}
+
+func TestResourceName_Transliterate(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceName().Schema, map[string]interface{}{
+		"name":          "Société-Générale",
+		"resource_type": "azurerm_resource_group",
+		"transliterate": true,
+	})
+	if _, err := getNameResult(d, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result := d.Get("result").(string); result != "rg-Societe-Generale" {
+		t.Errorf("expected rg-Societe-Generale, got %s", result)
+	}
+	if cleaned := d.Get("cleaned_characters").([]interface{}); !reflect.DeepEqual(cleaned, []interface{}{
+		map[string]interface{}{"resource_type": "azurerm_resource_group", "characters": map[string]interface{}{"é": "e"}},
+	}) {
+		t.Errorf("expected é to be replaced with e, got %v", cleaned)
+	}
+
+	// The characters are reported for each resource type
+	d = schema.TestResourceDataRaw(t, resourceName().Schema, map[string]interface{}{
+		"name":           "Société-Générale",
+		"resource_types": []interface{}{"azurerm_resource_group", "azurerm_storage_account"},
+		"transliterate":  true,
+	})
+	if _, err := getNameResult(d, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cleaned := d.Get("cleaned_characters").([]interface{}); !reflect.DeepEqual(cleaned, []interface{}{
+		map[string]interface{}{"resource_type": "azurerm_resource_group", "characters": map[string]interface{}{"é": "e"}},
+		map[string]interface{}{"resource_type": "azurerm_storage_account", "characters": map[string]interface{}{"é": "e", "-": "", "S": "", "G": ""}},
+	}) {
+		t.Errorf("expected the characters of each resource type, got %v", cleaned)
+	}
+
+	d = schema.TestResourceDataRaw(t, dataName().Schema, map[string]interface{}{
+		"name":          "straße",
+		"resource_type": "azurerm_storage_account",
+		"transliterate": true,
+	})
+	if diags := dataNameRead(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if result := d.Get("result").(string); result != "ststrasse" {
+		t.Errorf("expected ststrasse, got %s", result)
+	}
+	if cleaned := d.Get("cleaned_characters").(map[string]interface{}); !reflect.DeepEqual(cleaned, map[string]interface{}{"-": "", "ß": "ss"}) {
+		t.Errorf("expected the separator to be removed and ß to be replaced, got %v", cleaned)
+	}
+
+	d = schema.TestResourceDataRaw(t, dataName().Schema, map[string]interface{}{
+		"name":          "Straße",
+		"resource_type": "azurerm_storage_account",
+		"transliterate": true,
+		"passthrough":   true,
+	})
+	if diags := dataNameRead(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if result := d.Get("result").(string); result != "trasse" {
+		t.Errorf("expected trasse, got %s", result)
+	}
+	if cleaned := d.Get("cleaned_characters").(map[string]interface{}); !reflect.DeepEqual(cleaned, map[string]interface{}{"S": "", "ß": "ss"}) {
+		t.Errorf("expected the characters of the passthrough name, got %v", cleaned)
+	}
+}
@@ -0,0 +1,113 @@
+package azurecaf
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// Uniqueness policies applied to the names of the resource types of global scope
+const (
+	UniquenessPolicyOff  = "off"
+	UniquenessPolicyWarn = "warn"
+	UniquenessPolicyFail = "fail"
+)
+
+var uniquenessPolicies = []string{UniquenessPolicyOff, UniquenessPolicyWarn, UniquenessPolicyFail}
+
+// minUniquenessEntropyBits is the entropy below which the name of a global resource
+// type is likely to collide, 2^20 is about one million names. It is reached by 5
+// lowercase letters or 7 digits.
+const minUniquenessEntropyBits = 20.0
+
+// nameUniqueness is the estimated uniqueness of the name of a resource type.
+type nameUniqueness struct {
+	Resource *ResourceStructure
+	// EntropyBits is the entropy of the random and hash segments of the name
+	EntropyBits float64
+	// CollisionProbability is the probability that another name generated from the
+	// same inputs is identical
+	CollisionProbability float64
+}
+
+// estimateUniqueness estimates the entropy of the random and hash segments of the name
+// of a resource type, assuming they are not dropped to fit the maximum length.
+func estimateUniqueness(resource *ResourceStructure, inputs nameInputs) nameUniqueness {
+	format := inputs.Format
+	if format == nil {
+		format = legacyNameFormat(defaultNamePrecedence)
+	}
+	bits := 0.0
+	if !inputs.Passthrough {
+		if inputs.RandomLength > 0 && format.hasPlaceholder(PlaceholderRandom) {
+			if alphabet, err := randomCharacters(inputs.RandomCharacterSet, resource); err == nil {
+				bits += float64(inputs.RandomLength) * math.Log2(float64(len(alphabet)))
+			}
+		}
+		if inputs.HashLength > 0 && len(inputs.HashInputs) > 0 && format.hasPlaceholder(PlaceholderHash) {
+			if alphabet := allowedCharacters(resource, hashgenerator); len(alphabet) > 0 {
+				bits += float64(inputs.HashLength) * math.Log2(float64(len(alphabet)))
+			}
+		}
+	}
+	return nameUniqueness{
+		Resource:             resource,
+		EntropyBits:          bits,
+		CollisionProbability: math.Exp2(-bits),
+	}
+}
+
+// low reports whether the name of a global resource type has too little entropy.
+func (u nameUniqueness) low() bool {
+	return u.Resource.Scope == "global" && u.EntropyBits < minUniquenessEntropyBits
+}
+
+// diagnostic describes the collision risk of a name with too little entropy.
+func (u nameUniqueness) diagnostic(severity diag.Severity) diag.Diagnostic {
+	detail := fmt.Sprintf("The names of %s must be unique across Azure, but this name has no random nor hash segment: any name generated from the same inputs collides.", u.Resource.ResourceTypeName)
+	if u.EntropyBits > 0 {
+		detail = fmt.Sprintf("The names of %s must be unique across Azure, but the random and hash segments of this name only carry %.1f bits of entropy: the estimated probability that another name generated from the same inputs collides is 1 in %.0f (%.3g).", u.Resource.ResourceTypeName, u.EntropyBits, 1/u.CollisionProbability, u.CollisionProbability)
+	}
+	return diag.Diagnostic{
+		Severity: severity,
+		Summary:  fmt.Sprintf("Name of the global resource type %s is likely to collide", u.Resource.ResourceTypeName),
+		Detail:   fmt.Sprintf("%s Increase random_length or hash_length to reach at least %.0f bits, e.g. 5 lowercase letters.", detail, minUniquenessEntropyBits),
+	}
+}
+
+// checkUniqueness applies the uniqueness policy of inputs to the resource types of a
+// name. It returns the highest collision probability among them, along with a warning
+// (warn policy) or an error (fail policy) for every global type with too little entropy.
+func checkUniqueness(resources []*ResourceStructure, inputs nameInputs) (float64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	probability := 0.0
+	for _, resource := range resources {
+		uniqueness := estimateUniqueness(resource, inputs)
+		probability = math.Max(probability, uniqueness.CollisionProbability)
+		if !uniqueness.low() {
+			continue
+		}
+		switch inputs.UniquenessPolicy {
+		case UniquenessPolicyWarn:
+			diags = append(diags, uniqueness.diagnostic(diag.Warning))
+		case UniquenessPolicyFail:
+			diags = append(diags, uniqueness.diagnostic(diag.Error))
+		}
+	}
+	return probability, diags
+}
+
+// diagnosticsError returns the first error of diags as an error.
+func diagnosticsError(diags diag.Diagnostics) error {
+	for _, d := range diags {
+		if d.Severity != diag.Error {
+			continue
+		}
+		if d.Detail == "" {
+			return fmt.Errorf("%s", d.Summary)
+		}
+		return fmt.Errorf("%s: %s", d.Summary, d.Detail)
+	}
+	return nil
+}
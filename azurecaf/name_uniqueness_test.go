@@ -0,0 +1,174 @@
+package azurecaf
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestEstimateUniqueness(t *testing.T) {
+	storage := ResourceDefinitions["azurerm_storage_account"]
+	noRandom, _ := parseNameFormat("{slug}{name}")
+	cases := []struct {
+		name   string
+		inputs nameInputs
+		bits   float64
+	}{
+		{"no random", nameInputs{}, 0},
+		{"lowercase random", nameInputs{RandomLength: 5}, 5 * math.Log2(26)},
+		{"numeric random", nameInputs{RandomLength: 6, RandomCharacterSet: RandomCharactersNumeric}, 6 * math.Log2(10)},
+		{"uppercase not allowed", nameInputs{RandomLength: 4, RandomCharacterSet: RandomCharactersAlphanumeric}, 4 * math.Log2(36)},
+		{"random and hash", nameInputs{RandomLength: 2, HashLength: 3, HashInputs: []string{"sub"}}, 2*math.Log2(26) + 3*math.Log2(36)},
+		{"hash without inputs", nameInputs{HashLength: 3}, 0},
+		{"random left out of the format", nameInputs{RandomLength: 5, Format: noRandom}, 0},
+		{"passthrough", nameInputs{RandomLength: 5, Passthrough: true}, 0},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			uniqueness := estimateUniqueness(&storage, tt.inputs)
+			if math.Abs(uniqueness.EntropyBits-tt.bits) > 1e-9 {
+				t.Errorf("expected %.2f bits, got %.2f", tt.bits, uniqueness.EntropyBits)
+			}
+			if math.Abs(uniqueness.CollisionProbability-math.Exp2(-tt.bits)) > 1e-12 {
+				t.Errorf("unexpected collision probability %g", uniqueness.CollisionProbability)
+			}
+		})
+	}
+}
+
+func TestCheckUniqueness(t *testing.T) {
+	storage := ResourceDefinitions["azurerm_storage_account"]
+	resourceGroup := ResourceDefinitions["azurerm_resource_group"]
+	resources := []*ResourceStructure{&storage, &resourceGroup}
+
+	probability, diags := checkUniqueness(resources, nameInputs{RandomLength: 3, UniquenessPolicy: UniquenessPolicyWarn})
+	if len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Summary, "azurerm_storage_account") {
+		t.Fatalf("expected a single warning for the storage account, got %v", diags)
+	}
+	if !strings.Contains(diags[0].Detail, "1 in 17576") {
+		t.Errorf("expected the collision probability in the detail, got %s", diags[0].Detail)
+	}
+	if probability != math.Exp2(-3*math.Log2(26)) {
+		t.Errorf("unexpected collision probability %g", probability)
+	}
+
+	if _, diags := checkUniqueness(resources, nameInputs{UniquenessPolicy: UniquenessPolicyFail}); !diags.HasError() || !strings.Contains(diagnosticsError(diags).Error(), "no random nor hash segment") {
+		t.Errorf("expected an error, got %v", diags)
+	}
+	if _, diags := checkUniqueness(resources, nameInputs{RandomLength: 5, UniquenessPolicy: UniquenessPolicyFail}); len(diags) != 0 {
+		t.Errorf("expected 5 random letters to be enough, got %v", diags)
+	}
+	if _, diags := checkUniqueness(resources, nameInputs{UniquenessPolicy: UniquenessPolicyOff}); len(diags) != 0 {
+		t.Errorf("expected no diagnostic when the policy is off, got %v", diags)
+	}
+}
+
+func TestDataName_UniquenessPolicy(t *testing.T) {
+	warn := UniquenessPolicyWarn
+	meta := &providerConfig{Defaults: namingDefaults{UniquenessPolicy: &warn}}
+
+	d := schema.TestResourceDataRaw(t, dataName().Schema, map[string]interface{}{
+		"name":          "contoso",
+		"resource_type": "azurerm_storage_account",
+	})
+	diags := dataNameRead(context.Background(), d, meta)
+	if diags.HasError() || len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected the provider policy to raise a warning, got %v", diags)
+	}
+	if probability := d.Get("collision_probability").(float64); probability != 1 {
+		t.Errorf("expected a collision probability of 1, got %g", probability)
+	}
+
+	d = schema.TestResourceDataRaw(t, dataName().Schema, map[string]interface{}{
+		"name":              "contoso",
+		"resource_type":     "azurerm_storage_account",
+		"uniqueness_policy": UniquenessPolicyFail,
+	})
+	if diags := dataNameRead(context.Background(), d, meta); !diags.HasError() {
+		t.Errorf("expected the resource policy to override the provider one, got %v", diags)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceName().Schema, map[string]interface{}{
+		"name":              "contoso",
+		"resource_type":     "azurerm_resource_group",
+		"resource_types":    []interface{}{"azurerm_key_vault"},
+		"random_length":     4,
+		"uniqueness_policy": UniquenessPolicyFail,
+	})
+	if _, err := getNameResult(d, nil); err == nil || !strings.Contains(err.Error(), "azurerm_key_vault") {
+		t.Errorf("expected the key vault name to fail, got %v", err)
+	}
+}
+
+func TestDataNames_UniquenessPolicy(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataNames().Schema, map[string]interface{}{
+		"uniqueness_policy": UniquenessPolicyWarn,
+		"entry": []interface{}{
+			map[string]interface{}{"key": "rg", "name": "billing", "resource_type": "azurerm_resource_group"},
+			map[string]interface{}{"key": "storage", "name": "billing", "resource_type": "azurerm_storage_account"},
+			map[string]interface{}{"key": "vault", "name": "billing", "resource_type": "azurerm_key_vault", "random_length": 5},
+		},
+	})
+	diags := dataNamesRead(context.Background(), d, nil)
+	if diags.HasError() || len(diags) != 1 || !strings.HasPrefix(diags[0].Summary, "storage: ") {
+		t.Fatalf("expected a single warning for the storage entry, got %v", diags)
+	}
+	if results := d.Get("results").(map[string]interface{}); len(results) != 3 {
+		t.Errorf("expected every name to be generated, got %v", results)
+	}
+}
+
+// testApply plans and applies the configuration raw of a new resource, as Terraform
+// does, and returns the diagnostics of the apply.
+func testApply(t *testing.T, r *schema.Resource, raw map[string]interface{}, meta interface{}) (*terraform.InstanceState, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+	encoded, _ := json.Marshal(raw)
+	rawConfig, err := ctyjson.Unmarshal(encoded, r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatalf("unexpected config error: %v", err)
+	}
+	diff, err := r.Diff(ctx, &terraform.InstanceState{RawConfig: rawConfig}, terraform.NewResourceConfigRaw(raw), meta)
+	if err != nil {
+		t.Fatalf("unexpected diff error: %v", err)
+	}
+	diff.RawConfig = rawConfig
+	return r.Apply(ctx, nil, diff, meta)
+}
+
+func TestResourceName_UniquenessWarnings(t *testing.T) {
+	state, diags := testApply(t, resourceName(), map[string]interface{}{
+		"name":              "contoso",
+		"resource_type":     "azurerm_storage_account",
+		"uniqueness_policy": UniquenessPolicyWarn,
+	}, nil)
+	if diags.HasError() || len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Summary, "azurerm_storage_account") {
+		t.Fatalf("expected the warning of the storage account, got %v", diags)
+	}
+	if state == nil || state.Attributes["result"] != "stcontoso" {
+		t.Errorf("expected the name to be created, got %v", state)
+	}
+}
+
+func TestResourceNames_UniquenessWarnings(t *testing.T) {
+	state, diags := testApply(t, resourceNames(), map[string]interface{}{
+		"uniqueness_policy": UniquenessPolicyWarn,
+		"entry": []interface{}{
+			map[string]interface{}{"key": "rg", "name": "billing", "resource_type": "azurerm_resource_group"},
+			map[string]interface{}{"key": "storage", "name": "billing", "resource_type": "azurerm_storage_account"},
+		},
+	}, nil)
+	if diags.HasError() || len(diags) != 1 || !strings.HasPrefix(diags[0].Summary, "storage: ") {
+		t.Fatalf("expected a single warning for the storage entry, got %v", diags)
+	}
+	if state == nil || state.Attributes["results.storage"] != "stbilling" {
+		t.Errorf("expected the planned names to be stored, got %v", state)
+	}
+}
@@ -0,0 +1,127 @@
+package azurecaf
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Kinds of the naming rules violations.
+const (
+	ViolationTooShort         = "too_short"
+	ViolationTooLong          = "too_long"
+	ViolationInvalidCharacter = "invalid_character"
+	ViolationUppercase        = "uppercase"
+	ViolationInvalidStart     = "invalid_start"
+	ViolationInvalidEnd       = "invalid_end"
+	ViolationPattern          = "pattern"
+)
+
+// nameViolation describes a naming rule an existing name does not comply with.
+type nameViolation struct {
+	Kind    string
+	Message string
+	// Position is the index of the offending character, or -1 when the violation is not about a character
+	Position  int
+	Character string
+}
+
+// validateName checks a name against the rules of a resource type and returns the
+// list of the rules it does not comply with, in the order of the name.
+func validateName(name string, resource *ResourceStructure) ([]nameViolation, error) {
+	validationRegEx, err := regexp.Compile(resource.ValidationRegExp)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validation regex for resource type %s: %w", resource.ResourceTypeName, err)
+	}
+	cleaningRegEx, err := regexp.Compile(resource.RegEx)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regex for resource type %s: %w", resource.ResourceTypeName, err)
+	}
+
+	violations := []nameViolation{}
+	characters := []rune(name)
+	length := len(characters)
+	if length < resource.MinLength {
+		violations = append(violations, nameViolation{
+			Kind:     ViolationTooShort,
+			Message:  fmt.Sprintf("name is too short: %d < %d", length, resource.MinLength),
+			Position: -1,
+		})
+	}
+	if length > resource.MaxLength {
+		violations = append(violations, nameViolation{
+			Kind:     ViolationTooLong,
+			Message:  fmt.Sprintf("name is too long: %d > %d", length, resource.MaxLength),
+			Position: -1,
+		})
+	}
+
+	allowed := make([]bool, len(characters))
+	for i, c := range characters {
+		// Uppercase characters of the lowercase types are reported as a case violation
+		character := string(c)
+		if resource.LowerCase {
+			character = strings.ToLower(character)
+		}
+		allowed[i] = !cleaningRegEx.MatchString(character)
+		if !allowed[i] {
+			violations = append(violations, nameViolation{
+				Kind:      ViolationInvalidCharacter,
+				Message:   fmt.Sprintf("character %q at position %d is not allowed", c, i),
+				Position:  i,
+				Character: string(c),
+			})
+			continue
+		}
+		if character != string(c) {
+			violations = append(violations, nameViolation{
+				Kind:      ViolationUppercase,
+				Message:   fmt.Sprintf("character %q at position %d must be lowercase", c, i),
+				Position:  i,
+				Character: string(c),
+			})
+		}
+	}
+
+	if len(characters) > 0 {
+		rules := characterRulesFor(resource)
+		first, last := 0, len(characters)-1
+		if allowed[first] && !allowedAtEdge(rules.Start, characters[first], resource) {
+			violations = append(violations, nameViolation{
+				Kind:      ViolationInvalidStart,
+				Message:   fmt.Sprintf("name must not start with %q", characters[first]),
+				Position:  first,
+				Character: string(characters[first]),
+			})
+		}
+		if last > first && allowed[last] && !allowedAtEdge(rules.End, characters[last], resource) {
+			violations = append(violations, nameViolation{
+				Kind:      ViolationInvalidEnd,
+				Message:   fmt.Sprintf("name must not end with %q", characters[last]),
+				Position:  last,
+				Character: string(characters[last]),
+			})
+		}
+	}
+
+	if len(violations) == 0 && !validationRegEx.MatchString(name) {
+		violations = append(violations, nameViolation{
+			Kind:     ViolationPattern,
+			Message:  fmt.Sprintf("name does not match the pattern %s", resource.ValidationRegExp),
+			Position: -1,
+		})
+	}
+	return violations, nil
+}
+
+// allowedAtEdge reports whether a name may start or end with c according to the rule
+// of that end, the characters of the lowercase types being checked in lowercase.
+func allowedAtEdge(rule characterRule, c rune, resource *ResourceStructure) bool {
+	if rule == nil {
+		return true
+	}
+	if resource.LowerCase {
+		c = []rune(strings.ToLower(string(c)))[0]
+	}
+	return rule(c)
+}
@@ -6,9 +6,14 @@
 //
 // Key components:
 //   - azurecaf_name resource: Creates names with full validation and customization options
+//   - azurecaf_names resource: Creates many names sharing the same options in one block
 //   - azurecaf_naming_convention resource: Legacy naming convention resource (deprecated)
 //   - azurecaf_name data source: Generates names during plan phase for early validation
 //   - azurecaf_environment_variable data source: Retrieves environment variables
+//   - azurecaf_name_validation data source: Reports why an existing name breaks the naming rules
+//   - azurecaf_names data source: Generates many names during plan phase
+//   - azurecaf_resource_definition(s) data sources: Expose the naming rules of the resource types
+//   - azurecaf_rules_diff data source: Lists the naming rule changes between two rules versions
 //
 // The provider supports multiple naming conventions including CAF classic, CAF random,
 // passthrough, and fully random naming strategies.
@@ -24,28 +29,42 @@ import (
 // Resources:
 //   - azurecaf_naming_convention: Legacy naming convention resource (use azurecaf_name instead)
 //   - azurecaf_name: Primary resource for generating Azure-compliant resource names
+//   - azurecaf_names: Bulk generation of names keyed by logical names
 //
 // Data Sources:
 //   - azurecaf_environment_variable: Retrieves environment variables with validation
 //   - azurecaf_name: Generates names during plan phase for early validation
+//   - azurecaf_name_validation: Checks an existing name and lists its violations
+//   - azurecaf_names: Generates many names during plan phase
+//   - azurecaf_resource_definition: Naming rules of a resource type
+//   - azurecaf_resource_definitions: Naming rules of the resource types matching filters
+//   - azurecaf_rules_diff: Naming rule changes between two rules versions
 //
-// The provider requires no configuration parameters and works out-of-the-box with
-// the built-in Azure resource definitions.
+// The provider works out-of-the-box with the built-in Azure resource definitions.
+// Its optional configuration holds naming defaults and named profiles that are
+// merged with the arguments of the azurecaf_name resource and data source.
 func Provider() *schema.Provider {
 	return &schema.Provider{
-		// No provider-level configuration required
-		Schema: map[string]*schema.Schema{},
+		// Optional naming defaults and profiles
+		Schema:               providerSchema(),
+		ConfigureContextFunc: providerConfigure,
 
 		// Resources that can be created and managed
 		ResourcesMap: map[string]*schema.Resource{
 			"azurecaf_naming_convention": resourceNamingConvention(), // Legacy - use azurecaf_name instead
 			"azurecaf_name":              resourceName(),             // Primary naming resource
+			"azurecaf_names":             resourceNames(),            // Bulk naming resource
 		},
 
 		// Data sources for retrieving information
 		DataSourcesMap: map[string]*schema.Resource{
 			"azurecaf_environment_variable": dataEnvironmentVariable(), // Environment variable lookup
 			"azurecaf_name":                 dataName(),                // Name generation during plan
+			"azurecaf_name_validation":      dataNameValidation(),      // Validation of existing names
+			"azurecaf_names":                dataNames(),               // Bulk name generation during plan
+			"azurecaf_resource_definition":  dataResourceDefinition(),  // Naming rules of a resource type
+			"azurecaf_resource_definitions": dataResourceDefinitions(), // Catalog of the naming rules
+			"azurecaf_rules_diff":           dataRulesDiff(),           // Changes between rules versions
 		},
 	}
 }
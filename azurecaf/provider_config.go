@@ -0,0 +1,419 @@
+package azurecaf
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// providerConfig holds the provider-level configuration shared by all the
+// resources and data sources. It is returned by providerConfigure and handed
+// over to the CRUD functions as meta.
+type providerConfig struct {
+	// Defaults are the organization wide naming defaults
+	Defaults namingDefaults
+	// Profiles are the named sets of defaults layered on top of Defaults
+	Profiles map[string]namingDefaults
+	// Definitions are the built-in resource definitions of RulesVersion extended with
+	// the custom ones, nil when neither is configured
+	Definitions *resourceDefinitionSet
+	// RulesVersion pins the snapshot of the built-in definitions, empty for the latest
+	RulesVersion string
+	// CustomDefinitions are the custom resource definitions, layered on top of the
+	// snapshot selected by the rules_version of a resource
+	CustomDefinitions []ResourceStructure
+}
+
+// namingDefaults is a set of optional naming inputs. A nil field means the value
+// was not configured and must not override a lower precedence value.
+type namingDefaults struct {
+	Prefixes         []string
+	Suffixes         []string
+	Separator        *string
+	RandomLength     *int
+	CleanInput       *bool
+	UniquenessPolicy *string
+}
+
+// nameInputs gathers the naming arguments of azurecaf_name once the provider
+// defaults and the selected profile have been merged in.
+type nameInputs struct {
+	Name                        string
+	Prefixes                    []string
+	Suffixes                    []string
+	Separator                   string
+	RandomLength                int
+	RandomSeed                  int64
+	RandomCharacterSet          string
+	HashInputs                  []string
+	HashLength                  int
+	CleanInput                  bool
+	Transliterate               bool
+	Passthrough                 bool
+	UseSlug                     bool
+	ErrorWhenExceedingMaxLength bool
+	Shortening                  string
+	Padding                     string
+	Case                        string
+	// Format lays out the name, the historical composition is used when nil
+	Format       *nameFormat
+	FormatValues map[string]string
+	// SegmentPriorities and RequiredSegments override the fitting of the placeholders
+	SegmentPriorities map[string]int
+	RequiredSegments  []string
+	// UniquenessPolicy is applied to the names of the global resource types
+	UniquenessPolicy string
+	// RulesVersion selects the snapshot of the definitions, empty for the one of the provider
+	RulesVersion string
+	// AdaptSeparator replaces the separator with the fallback of the resource types not allowing it
+	AdaptSeparator bool
+	// Separators override the separator, keyed by resource type name
+	Separators map[string]string
+}
+
+// namingDefaultsSchema returns the attributes that can be defaulted at the provider
+// level, either in the defaults block or in a profile block.
+func namingDefaultsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"prefixes": {
+			Type: schema.TypeList,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Optional:    true,
+			Description: "Default list of prefixes to prepend to the generated names.",
+		},
+		"suffixes": {
+			Type: schema.TypeList,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Optional:    true,
+			Description: "Default list of suffixes to append to the generated names.",
+		},
+		"separator": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Default separator character used between name components.",
+		},
+		"random_length": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "Default number of random characters to append to the names.",
+		},
+		"clean_input": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Default for removing the characters that are not allowed by the Azure resource naming rules.",
+		},
+		"uniqueness_policy": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(uniquenessPolicies, false),
+			Description:  "Default policy applied to the names of the resource types of global scope, e.g. storage accounts, whose random and hash segments carry too little entropy: off, warn or fail.",
+		},
+	}
+}
+
+// providerSchema returns the provider configuration schema.
+func providerSchema() map[string]*schema.Schema {
+	profileSchema := namingDefaultsSchema()
+	profileSchema["name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotEmpty,
+		Description:  "Name of the profile, referenced by the profile attribute of azurecaf_name.",
+	}
+
+	return map[string]*schema.Schema{
+		"defaults": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: namingDefaultsSchema(),
+			},
+			Description: "Organization wide defaults applied to every azurecaf_name resource and data source.",
+		},
+		"profile": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: profileSchema,
+			},
+			Description: "Named set of defaults, selected with the profile attribute of azurecaf_name. Values set in a profile override the defaults block.",
+		},
+		"resource_definitions_file": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			Description:  "Path to a JSON file, in the format of resourceDefinition.json, holding additional resource definitions or overrides of the built-in ones.",
+		},
+		"resource_definitions": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+			Description:  "Inline JSON document, in the format of resourceDefinition.json, holding additional resource definitions or overrides of the built-in ones. Its entries are loaded after the ones of resource_definitions_file.",
+		},
+		"rules_version": rulesVersionSchema(false, "Version of the built-in naming rules used by every resource and data source, e.g. v1, so that upgrading the provider never changes the generated names. Defaults to latest, the rules of the installed provider release."),
+	}
+}
+
+// providerConfigure reads the provider configuration block.
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	config := &providerConfig{
+		Profiles: map[string]namingDefaults{},
+	}
+	rawConfig := d.GetRawConfig()
+
+	if blocks := d.Get("defaults").([]interface{}); len(blocks) > 0 && blocks[0] != nil {
+		config.Defaults = expandNamingDefaults(blocks[0].(map[string]interface{}), rawBlock(rawConfig, "defaults", 0))
+	}
+
+	for i, block := range d.Get("profile").([]interface{}) {
+		if block == nil {
+			continue
+		}
+		values := block.(map[string]interface{})
+		profileName := values["name"].(string)
+		if _, exists := config.Profiles[profileName]; exists {
+			return nil, diag.Errorf("profile %q is defined more than once in the provider configuration", profileName)
+		}
+		config.Profiles[profileName] = expandNamingDefaults(values, rawBlock(rawConfig, "profile", i))
+	}
+
+	config.RulesVersion = d.Get("rules_version").(string)
+	builtin, err := rulesDefinitionSet(config.RulesVersion)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if config.RulesVersion != "" {
+		config.Definitions = &builtin
+	}
+
+	var diags diag.Diagnostics
+	definitionsFile := d.Get("resource_definitions_file").(string)
+	inlineDefinitions := d.Get("resource_definitions").(string)
+	if definitionsFile != "" || inlineDefinitions != "" {
+		custom, loadDiags := loadCustomResourceDefinitions(definitionsFile, inlineDefinitions)
+		diags = append(diags, loadDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		var setDiags diag.Diagnostics
+		config.Definitions, setDiags = builtin.extend(custom)
+		diags = append(diags, setDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.CustomDefinitions = custom
+	}
+
+	return config, diags
+}
+
+// rawBlock returns the raw configuration of the index-th element of a nested block,
+// or a null value when the raw configuration is not available.
+func rawBlock(rawConfig cty.Value, key string, index int) cty.Value {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.Type().IsObjectType() || !rawConfig.Type().HasAttribute(key) {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+	blocks := rawConfig.GetAttr(key)
+	if blocks.IsNull() || !blocks.IsKnown() || !blocks.CanIterateElements() || blocks.LengthInt() <= index {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+	return blocks.Index(cty.NumberIntVal(int64(index)))
+}
+
+// rawAttributeSet reports whether key is set in the raw block. When the raw block is
+// not available, a non zero value is considered set.
+func rawAttributeSet(rawBlock cty.Value, key string, value interface{}) bool {
+	if rawBlock.IsNull() || !rawBlock.Type().IsObjectType() || !rawBlock.Type().HasAttribute(key) {
+		switch v := value.(type) {
+		case string:
+			return v != ""
+		case int:
+			return v != 0
+		case bool:
+			return v
+		case []interface{}:
+			return len(v) > 0
+		}
+		return false
+	}
+	return !rawBlock.GetAttr(key).IsNull()
+}
+
+func expandNamingDefaults(values map[string]interface{}, rawBlock cty.Value) namingDefaults {
+	defaults := namingDefaults{}
+	if v, ok := values["prefixes"].([]interface{}); ok && rawAttributeSet(rawBlock, "prefixes", v) {
+		defaults.Prefixes = convertInterfaceToString(v)
+	}
+	if v, ok := values["suffixes"].([]interface{}); ok && rawAttributeSet(rawBlock, "suffixes", v) {
+		defaults.Suffixes = convertInterfaceToString(v)
+	}
+	if v, ok := values["separator"].(string); ok && rawAttributeSet(rawBlock, "separator", v) {
+		defaults.Separator = &v
+	}
+	if v, ok := values["random_length"].(int); ok && rawAttributeSet(rawBlock, "random_length", v) {
+		defaults.RandomLength = &v
+	}
+	if v, ok := values["clean_input"].(bool); ok && rawAttributeSet(rawBlock, "clean_input", v) {
+		defaults.CleanInput = &v
+	}
+	if v, ok := values["uniqueness_policy"].(string); ok && rawAttributeSet(rawBlock, "uniqueness_policy", v) {
+		defaults.UniquenessPolicy = &v
+	}
+	return defaults
+}
+
+// merge returns the defaults where every value set in override replaces the one of d.
+func (d namingDefaults) merge(override namingDefaults) namingDefaults {
+	if override.Prefixes != nil {
+		d.Prefixes = override.Prefixes
+	}
+	if override.Suffixes != nil {
+		d.Suffixes = override.Suffixes
+	}
+	if override.Separator != nil {
+		d.Separator = override.Separator
+	}
+	if override.RandomLength != nil {
+		d.RandomLength = override.RandomLength
+	}
+	if override.CleanInput != nil {
+		d.CleanInput = override.CleanInput
+	}
+	if override.UniquenessPolicy != nil {
+		d.UniquenessPolicy = override.UniquenessPolicy
+	}
+	return d
+}
+
+// namingDefaultsFor returns the defaults of the provider merged with the given profile.
+func namingDefaultsFor(meta interface{}, profile string) (namingDefaults, error) {
+	config, ok := meta.(*providerConfig)
+	if !ok || config == nil {
+		if profile != "" {
+			return namingDefaults{}, fmt.Errorf("profile %q is not defined in the provider configuration", profile)
+		}
+		return namingDefaults{}, nil
+	}
+	if profile == "" {
+		return config.Defaults, nil
+	}
+	profileDefaults, exists := config.Profiles[profile]
+	if !exists {
+		available := make([]string, 0, len(config.Profiles))
+		for k := range config.Profiles {
+			available = append(available, k)
+		}
+		sort.Strings(available)
+		return namingDefaults{}, fmt.Errorf("profile %q is not defined in the provider configuration, available profiles: [%s]", profile, strings.Join(available, ", "))
+	}
+	return config.Defaults.merge(profileDefaults), nil
+}
+
+// attributeReader reads the configuration of a resource, it is implemented by both
+// schema.ResourceData and schema.ResourceDiff.
+type attributeReader interface {
+	Get(key string) interface{}
+	GetRawConfig() cty.Value
+}
+
+// isConfigured reports whether key is explicitly set in the configuration of d.
+// When the raw configuration is not available, which is the case for a ResourceData
+// built outside of a Terraform operation, a value that differs from the schema
+// default is considered configured.
+func isConfigured(d attributeReader, key string, schemaDefault interface{}) bool {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.Type().IsObjectType() || !rawConfig.Type().HasAttribute(key) {
+		value := d.Get(key)
+		if list, ok := value.([]interface{}); ok {
+			return len(list) > 0
+		}
+		return value != schemaDefault
+	}
+	return !rawConfig.GetAttr(key).IsNull()
+}
+
+// readNameInputs reads the naming arguments of d and completes the ones that are
+// not configured with the provider defaults and the selected profile.
+func readNameInputs(d *schema.ResourceData, meta interface{}) (nameInputs, error) {
+	inputs := nameInputs{
+		Name:                        d.Get("name").(string),
+		Prefixes:                    convertInterfaceToString(d.Get("prefixes").([]interface{})),
+		Suffixes:                    convertInterfaceToString(d.Get("suffixes").([]interface{})),
+		Separator:                   d.Get("separator").(string),
+		RandomLength:                d.Get("random_length").(int),
+		RandomSeed:                  int64(d.Get("random_seed").(int)),
+		RandomCharacterSet:          d.Get("random_character_set").(string),
+		HashInputs:                  convertInterfaceToString(d.Get("hash_inputs").([]interface{})),
+		HashLength:                  d.Get("hash_length").(int),
+		CleanInput:                  d.Get("clean_input").(bool),
+		Transliterate:               d.Get("transliterate").(bool),
+		Passthrough:                 d.Get("passthrough").(bool),
+		UseSlug:                     d.Get("use_slug").(bool),
+		ErrorWhenExceedingMaxLength: d.Get("error_when_exceeding_max_length").(bool),
+		Shortening:                  d.Get("shortening").(string),
+		Padding:                     d.Get("padding").(string),
+		Case:                        d.Get("case").(string),
+		FormatValues:                convertInterfaceMapToString(d.Get("format_values").(map[string]interface{})),
+		SegmentPriorities:           convertInterfaceMapToInt(d.Get("segment_priorities").(map[string]interface{})),
+		RequiredSegments:            convertInterfaceToString(d.Get("required_segments").([]interface{})),
+		UniquenessPolicy:            d.Get("uniqueness_policy").(string),
+		RulesVersion:                d.Get("rules_version").(string),
+	}
+
+	if template := d.Get("format").(string); template != "" {
+		format, err := parseNameFormat(template)
+		if err != nil {
+			return inputs, err
+		}
+		inputs.Format = format
+	}
+	if err := validateFormatValues(inputs.FormatValues); err != nil {
+		return inputs, err
+	}
+
+	err := applyNamingDefaults(d, meta, &inputs)
+	return inputs, err
+}
+
+// applyNamingDefaults completes the inputs that are not configured in d with the
+// provider defaults and the selected profile.
+func applyNamingDefaults(d attributeReader, meta interface{}, inputs *nameInputs) error {
+	defaults, err := namingDefaultsFor(meta, d.Get("profile").(string))
+	if err != nil {
+		return err
+	}
+
+	if defaults.Prefixes != nil && !isConfigured(d, "prefixes", nil) {
+		inputs.Prefixes = append([]string{}, defaults.Prefixes...)
+	}
+	if defaults.Suffixes != nil && !isConfigured(d, "suffixes", nil) {
+		inputs.Suffixes = append([]string{}, defaults.Suffixes...)
+	}
+	if defaults.Separator != nil && !isConfigured(d, "separator", "-") {
+		inputs.Separator = *defaults.Separator
+	}
+	if defaults.RandomLength != nil && !isConfigured(d, "random_length", 0) {
+		inputs.RandomLength = *defaults.RandomLength
+	}
+	if defaults.CleanInput != nil && !isConfigured(d, "clean_input", true) {
+		inputs.CleanInput = *defaults.CleanInput
+	}
+	if defaults.UniquenessPolicy != nil && !isConfigured(d, "uniqueness_policy", "") {
+		inputs.UniquenessPolicy = *defaults.UniquenessPolicy
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+package azurecaf
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testProviderMeta(t *testing.T, raw map[string]interface{}) interface{} {
+	t.Helper()
+	provider := Provider()
+	diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(raw))
+	if diags.HasError() {
+		t.Fatalf("unexpected error configuring the provider: %v", diags)
+	}
+	return provider.Meta()
+}
+
+func testProviderProfilesConfig() map[string]interface{} {
+	return map[string]interface{}{
+		"defaults": []interface{}{
+			map[string]interface{}{
+				"prefixes":      []interface{}{"contoso"},
+				"separator":     "_",
+				"random_length": 3,
+			},
+		},
+		"profile": []interface{}{
+			map[string]interface{}{
+				"name":     "prod",
+				"prefixes": []interface{}{"prd"},
+				"suffixes": []interface{}{"001"},
+			},
+			map[string]interface{}{
+				"name":          "sandbox",
+				"random_length": 5,
+			},
+		},
+	}
+}
+
+func TestProviderConfigure_NoConfiguration(t *testing.T) {
+	meta := testProviderMeta(t, map[string]interface{}{})
+	config, ok := meta.(*providerConfig)
+	if !ok {
+		t.Fatalf("expected *providerConfig meta, got %T", meta)
+	}
+	if len(config.Profiles) != 0 {
+		t.Errorf("expected no profile, got %d", len(config.Profiles))
+	}
+	if config.Defaults.Separator != nil || config.Defaults.Prefixes != nil {
+		t.Errorf("expected empty defaults, got %+v", config.Defaults)
+	}
+}
+
+func TestProviderConfigure_DuplicateProfile(t *testing.T) {
+	provider := Provider()
+	diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"profile": []interface{}{
+			map[string]interface{}{"name": "prod"},
+			map[string]interface{}{"name": "prod"},
+		},
+	}))
+	if !diags.HasError() {
+		t.Fatal("expected an error for a duplicated profile")
+	}
+	if !strings.Contains(diags[0].Summary, `profile "prod" is defined more than once`) {
+		t.Errorf("unexpected error: %s", diags[0].Summary)
+	}
+}
+
+func TestNamingDefaultsFor(t *testing.T) {
+	meta := testProviderMeta(t, testProviderProfilesConfig())
+
+	defaults, err := namingDefaultsFor(meta, "prod")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(defaults.Prefixes, ",") != "prd" {
+		t.Errorf("expected profile prefixes to override the defaults, got %v", defaults.Prefixes)
+	}
+	if defaults.Separator == nil || *defaults.Separator != "_" {
+		t.Errorf("expected separator from the defaults block, got %v", defaults.Separator)
+	}
+	if defaults.RandomLength == nil || *defaults.RandomLength != 3 {
+		t.Errorf("expected random_length from the defaults block, got %v", defaults.RandomLength)
+	}
+
+	_, err = namingDefaultsFor(meta, "unknown")
+	if err == nil || !strings.Contains(err.Error(), "available profiles: [prod, sandbox]") {
+		t.Errorf("expected an error listing the available profiles, got %v", err)
+	}
+
+	_, err = namingDefaultsFor(nil, "prod")
+	if err == nil {
+		t.Error("expected an error when the provider is not configured")
+	}
+}
+
+func TestResourceName_ProviderDefaults(t *testing.T) {
+	meta := testProviderMeta(t, testProviderProfilesConfig())
+	nameResource := Provider().ResourcesMap["azurecaf_name"]
+
+	tests := []struct {
+		name     string
+		config   map[string]interface{}
+		expected string
+		suffix   string
+	}{
+		{
+			name: "defaults only",
+			config: map[string]interface{}{
+				"name":          "myrg",
+				"resource_type": "azurerm_resource_group",
+				"random_seed":   1,
+			},
+			expected: "contoso_rg_myrg_",
+		},
+		{
+			name: "profile overrides defaults",
+			config: map[string]interface{}{
+				"name":          "myrg",
+				"resource_type": "azurerm_resource_group",
+				"profile":       "prod",
+			},
+			expected: "prd_rg_myrg_",
+			suffix:   "_001",
+		},
+		{
+			name: "explicit values win",
+			config: map[string]interface{}{
+				"name":          "myrg",
+				"resource_type": "azurerm_resource_group",
+				"profile":       "prod",
+				"prefixes":      []interface{}{"dev"},
+				"suffixes":      []interface{}{"002"},
+				"separator":     ".",
+			},
+			expected: "dev.rg.myrg.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, nameResource.Schema, tt.config)
+			if _, err := getNameResult(d, meta); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			result := d.Get("result").(string)
+			if !strings.HasPrefix(result, tt.expected) || !strings.HasSuffix(result, tt.suffix) {
+				t.Errorf("expected result starting with %q and ending with %q, got %q", tt.expected, tt.suffix, result)
+			}
+		})
+	}
+}
+
+func TestDataName_UnknownProfile(t *testing.T) {
+	meta := testProviderMeta(t, testProviderProfilesConfig())
+	d := schema.TestResourceDataRaw(t, dataName().Schema, map[string]interface{}{
+		"name":          "myrg",
+		"resource_type": "azurerm_resource_group",
+		"profile":       "staging",
+	})
+	_, err := getNameReadResult(d, meta)
+	if err == nil || !strings.Contains(err.Error(), `profile "staging" is not defined`) {
+		t.Errorf("expected an unknown profile error, got %v", err)
+	}
+}
+
+func TestDataName_SandboxProfile(t *testing.T) {
+	meta := testProviderMeta(t, testProviderProfilesConfig())
+	d := schema.TestResourceDataRaw(t, dataName().Schema, map[string]interface{}{
+		"name":          "myrg",
+		"resource_type": "azurerm_resource_group",
+		"profile":       "sandbox",
+	})
+	if _, err := getNameReadResult(d, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result := d.Get("result").(string)
+	// contoso_rg_myrg_ followed by the 5 random characters of the sandbox profile
+	if !strings.HasPrefix(result, "contoso_rg_myrg_") || len(result) != len("contoso_rg_myrg_")+5 {
+		t.Errorf("unexpected result %q", result)
+	}
+}
@@ -0,0 +1,102 @@
+package azurecaf
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-mux/tf5muxserver"
+)
+
+// ProviderAddress is the registry address the provider is published under.
+const ProviderAddress = "registry.terraform.io/aztfmod/azurecaf"
+
+// ProtocolServer returns the protocol version 5 server of the provider.
+//
+// The resources and data sources are implemented with the Terraform Plugin SDK
+// in Provider(). The provider-defined functions require the Terraform Plugin
+// Framework, so both providers are muxed behind a single server.
+func ProtocolServer(ctx context.Context) (func() tfprotov5.ProviderServer, error) {
+	providers := []func() tfprotov5.ProviderServer{
+		Provider().GRPCProvider,
+		func() tfprotov5.ProviderServer {
+			return functionsServer{ProviderServer: providerserver.NewProtocol5(NewFunctionsProvider())()}
+		},
+	}
+
+	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)
+	if err != nil {
+		return nil, err
+	}
+	return muxServer.ProviderServer, nil
+}
+
+// functionsServer wraps the framework provider server so that the provider
+// configuration stays owned by the SDK provider. The muxed servers must expose
+// identical provider schemas, the framework server therefore does not declare
+// any and ignores the provider configuration, which functions never use.
+type functionsServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (s functionsServer) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	resp, err := s.ProviderServer.GetProviderSchema(ctx, req)
+	if resp != nil {
+		resp.Provider = nil
+	}
+	return resp, err
+}
+
+func (s functionsServer) PrepareProviderConfig(ctx context.Context, req *tfprotov5.PrepareProviderConfigRequest) (*tfprotov5.PrepareProviderConfigResponse, error) {
+	return &tfprotov5.PrepareProviderConfigResponse{}, nil
+}
+
+func (s functionsServer) ConfigureProvider(ctx context.Context, req *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error) {
+	return &tfprotov5.ConfigureProviderResponse{}, nil
+}
+
+var _ provider.ProviderWithFunctions = &functionsProvider{}
+
+// functionsProvider is the framework provider serving the provider-defined functions.
+type functionsProvider struct{}
+
+// NewFunctionsProvider returns the framework provider serving the provider-defined
+// functions:
+//   - provider::azurecaf::name: Generates a name like the azurecaf_name data source
+//   - provider::azurecaf::validate: Checks a name against the rules of a resource type
+//   - provider::azurecaf::parse: Returns the naming definition of a resource type
+func NewFunctionsProvider() provider.Provider {
+	return &functionsProvider{}
+}
+
+func (p *functionsProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "azurecaf"
+}
+
+func (p *functionsProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = providerschema.Schema{}
+}
+
+func (p *functionsProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+}
+
+func (p *functionsProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return nil
+}
+
+func (p *functionsProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *functionsProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		newNameFunction,
+		newValidateFunction,
+		newParseFunction,
+	}
+}
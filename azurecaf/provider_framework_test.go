@@ -0,0 +1,68 @@
+package azurecaf
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+func TestProtocolServer_Schema(t *testing.T) {
+	serverFactory, err := ProtocolServer(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error creating the mux server: %v", err)
+	}
+	server := serverFactory()
+
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	if _, ok := resp.ResourceSchemas["azurecaf_name"]; !ok {
+		t.Error("expected azurecaf_name resource to be served by the mux server")
+	}
+	if _, ok := resp.DataSourceSchemas["azurecaf_name"]; !ok {
+		t.Error("expected azurecaf_name data source to be served by the mux server")
+	}
+	if resp.Provider == nil || len(resp.Provider.Block.BlockTypes) == 0 {
+		t.Error("expected the provider schema of the SDK provider")
+	}
+
+	functions := make([]string, 0, len(resp.Functions))
+	for name := range resp.Functions {
+		functions = append(functions, name)
+	}
+	sort.Strings(functions)
+	if strings.Join(functions, ",") != "name,parse,validate" {
+		t.Errorf("unexpected functions %v", functions)
+	}
+}
+
+func TestFunctionsServer_ProviderConfiguration(t *testing.T) {
+	server := functionsServer{ProviderServer: providerserver.NewProtocol5(NewFunctionsProvider())()}
+
+	schemaResp, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if schemaResp.Provider != nil {
+		t.Error("expected the functions server not to declare a provider schema")
+	}
+	if len(schemaResp.Functions) != 3 {
+		t.Errorf("expected 3 functions, got %d", len(schemaResp.Functions))
+	}
+
+	configureResp, err := server.ConfigureProvider(context.Background(), &tfprotov5.ConfigureProviderRequest{})
+	if err != nil || len(configureResp.Diagnostics) > 0 {
+		t.Errorf("expected the provider configuration to be ignored, got %v %v", err, configureResp.Diagnostics)
+	}
+}
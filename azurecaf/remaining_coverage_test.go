@@ -16,7 +16,7 @@ func TestGetNameReadResultErrors(t *testing.T) {
 			"random_length": 5,
 		})
 
-		err := getNameReadResult(rd, nil)
+		_, err := getNameReadResult(rd, nil)
 		if err == nil {
 			t.Error("Expected error with invalid resource type but got none")
 		}
@@ -69,7 +69,7 @@ func TestGetNameResultMultipleResourceTypes(t *testing.T) {
 		"random_length":  5,
 	})
 
-	err := getNameResult(rd, nil)
+	_, err := getNameResult(rd, nil)
 	if err != nil {
 		t.Errorf("Unexpected error with multiple resource types: %v", err)
 	}
@@ -96,7 +96,7 @@ func TestGetNameResultOnlyResourceTypes(t *testing.T) {
 		"random_length":  5,
 	})
 
-	err := getNameResult(rd, nil)
+	_, err := getNameResult(rd, nil)
 	if err != nil {
 		t.Errorf("Unexpected error with only resource_types: %v", err)
 	}
@@ -111,7 +111,7 @@ func TestGetNameResultErrors(t *testing.T) {
 			"random_length":  5,
 		})
 
-		err := getNameResult(rd, nil)
+		_, err := getNameResult(rd, nil)
 		if err == nil {
 			t.Error("Expected error with invalid resource types but got none")
 		}
@@ -1,6 +1,7 @@
 package azurecaf
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -40,7 +41,7 @@ func TestResourceCoverage(t *testing.T) {
 				"clean_input":   true,
 			})
 
-			err := nameResource.Create(resourceData, nil)
+			err := diagnosticsError(nameResource.CreateContext(context.Background(), resourceData, nil))
 			if err != nil {
 				failedResources = append(failedResources, resourceType)
 				t.Errorf("Failed to create name for %s: %v", resourceType, err)
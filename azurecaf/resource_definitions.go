@@ -0,0 +1,398 @@
+package azurecaf
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aztfmod/terraform-provider-azurecaf/internal/resourcedefinition"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// resourceDefinitionSet is a set of resource definitions along with the lookup
+// table from the CAF slugs to the resource types.
+type resourceDefinitionSet struct {
+	// Definitions are the resource definitions keyed by resource type
+	Definitions map[string]ResourceStructure
+	// Slugs maps a CAF slug or slug alias to the resource type it stands for
+	Slugs map[string]string
+	// AmbiguousSlugs maps the slugs shared by several resource types without a
+	// canonical one to the resource types sharing them
+	AmbiguousSlugs map[string][]string
+	// ArmTypes maps a lowercase ARM resource type to the resource types recording it
+	ArmTypes map[string][]string
+}
+
+// builtinDefinitionSet holds the definitions compiled in from resourceDefinition.json.
+var builtinDefinitionSet = resourceDefinitionSet{
+	Definitions:    ResourceDefinitions,
+	Slugs:          ResourceMaps,
+	AmbiguousSlugs: AmbiguousSlugs,
+	ArmTypes:       armTypeIndex(ResourceDefinitions),
+}
+
+// getResource returns the definition of a resource type, a slug or an ARM resource
+// type. It fails when the slug or the ARM type is ambiguous.
+func (s resourceDefinitionSet) getResource(resourceType string) (*ResourceStructure, error) {
+	if resourceKey, existing := s.Slugs[resourceType]; existing {
+		resourceType = resourceKey
+	} else if candidates, ambiguous := s.AmbiguousSlugs[resourceType]; ambiguous {
+		return nil, ambiguousSlugError(resourceType, candidates)
+	}
+	if resource, resourceFound := s.Definitions[resourceType]; resourceFound {
+		return &resource, nil
+	}
+	if strings.Contains(resourceType, "/") {
+		return s.getResourceByArmType(resourceType)
+	}
+	return nil, fmt.Errorf("invalid resource type %s", resourceType)
+}
+
+// getResourceBySlug returns the definition of the resource type a CAF slug stands for.
+func (s resourceDefinitionSet) getResourceBySlug(slug string) (*ResourceStructure, error) {
+	if candidates, ambiguous := s.AmbiguousSlugs[slug]; ambiguous {
+		return nil, ambiguousSlugError(slug, candidates)
+	}
+	resourceType, exists := s.Slugs[slug]
+	if !exists {
+		return nil, fmt.Errorf("no resource type has the slug %s", slug)
+	}
+	return s.getResource(resourceType)
+}
+
+// ambiguousSlugError reports a slug shared by several resource types.
+func ambiguousSlugError(slug string, candidates []string) error {
+	return fmt.Errorf("slug %s is ambiguous, it matches the resource types: %s, use one of them as the resource type", slug, strings.Join(candidates, ", "))
+}
+
+// slugs returns the slug and the slug aliases of a definition. The slug is empty
+// for the definitions without one.
+func (resource ResourceStructure) slugs() []string {
+	return append([]string{resource.CafPrefix}, resource.SlugAliases...)
+}
+
+// resolveSlugs resolves the slugs claimed by the definitions the way the code
+// generator does, see resourcedefinition.ResolveSlugs.
+func resolveSlugs(definitions []ResourceStructure) (map[string]string, map[string][]string) {
+	claims := []resourcedefinition.SlugClaim{}
+	for _, resource := range definitions {
+		for _, slug := range resource.slugs() {
+			claims = append(claims, resourcedefinition.SlugClaim{Slug: slug, ResourceType: resource.ResourceTypeName, Canonical: resource.Canonical})
+		}
+	}
+	return resourcedefinition.ResolveSlugs(claims)
+}
+
+// slugOwners returns the resource types a slug stands for.
+func (s resourceDefinitionSet) slugOwners(slug string) []string {
+	if owner, exists := s.Slugs[slug]; exists {
+		return []string{owner}
+	}
+	return s.AmbiguousSlugs[slug]
+}
+
+// getResourceByArmType returns the definition of an ARM resource type, e.g.
+// Microsoft.Storage/storageAccounts, compared case-insensitively. When several
+// definitions share it, the canonical one is returned, as for the slugs, and the
+// lookup fails without a single canonical definition.
+func (s resourceDefinitionSet) getResourceByArmType(armType string) (*ResourceStructure, error) {
+	candidates := s.ArmTypes[strings.ToLower(armType)]
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("no resource type has the ARM type %s", armType)
+	}
+	canonical := []string{}
+	for _, candidate := range candidates {
+		if s.Definitions[candidate].Canonical {
+			canonical = append(canonical, candidate)
+		}
+	}
+	switch {
+	case len(candidates) == 1:
+		resource := s.Definitions[candidates[0]]
+		return &resource, nil
+	case len(canonical) == 1:
+		resource := s.Definitions[canonical[0]]
+		return &resource, nil
+	}
+	return nil, fmt.Errorf("ARM type %s is ambiguous, it matches the resource types: %s, use one of them as the resource type", armType, strings.Join(candidates, ", "))
+}
+
+// armTypeIndex maps the lowercase ARM resource types of the definitions to the
+// resource types recording them, sorted.
+func armTypeIndex(definitions map[string]ResourceStructure) map[string][]string {
+	index := map[string][]string{}
+	for resourceType, resource := range definitions {
+		if armType := resource.Official.ResourceProviderNamespace; armType != "" {
+			key := strings.ToLower(armType)
+			index[key] = append(index[key], resourceType)
+		}
+	}
+	for _, resourceTypes := range index {
+		sort.Strings(resourceTypes)
+	}
+	return index
+}
+
+// outOfDocSlugs returns the definitions of resourceTypes whose slug is missing from
+// the official CAF documentation, when the slugs are part of the names.
+func (s resourceDefinitionSet) outOfDocSlugs(resourceTypes []string, useSlug bool) []*ResourceStructure {
+	outOfDoc := []*ResourceStructure{}
+	if !useSlug {
+		return outOfDoc
+	}
+	seen := map[string]bool{}
+	for _, resourceType := range resourceTypes {
+		resource, err := s.getResource(resourceType)
+		if err != nil || !resource.OutOfDoc || resource.CafPrefix == "" || seen[resource.ResourceTypeName] {
+			continue
+		}
+		seen[resource.ResourceTypeName] = true
+		outOfDoc = append(outOfDoc, resource)
+	}
+	return outOfDoc
+}
+
+// outOfDocSlugWarnings returns a warning for every definition whose out-of-doc slug is used.
+func outOfDocSlugWarnings(resources []*ResourceStructure) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, resource := range resources {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("Slug %q of %s is not an official CAF abbreviation", resource.CafPrefix, resource.ResourceTypeName),
+			Detail:   fmt.Sprintf("%s is missing from the official CAF documentation, its slug %s was chosen by the provider. Other tools may use another abbreviation, and the slug may change once the resource type is documented.", resource.ResourceTypeName, resource.CafPrefix),
+		})
+	}
+	return diags
+}
+
+// sorted returns the definitions sorted by resource type.
+func (s resourceDefinitionSet) sorted() []ResourceStructure {
+	definitions := make([]ResourceStructure, 0, len(s.Definitions))
+	for _, resource := range s.Definitions {
+		definitions = append(definitions, resource)
+	}
+	sort.Slice(definitions, func(i, j int) bool {
+		return definitions[i].ResourceTypeName < definitions[j].ResourceTypeName
+	})
+	return definitions
+}
+
+// definitionsFromMeta returns the definitions of the configured provider, which
+// include the custom definitions, or the built-in definitions.
+func definitionsFromMeta(meta interface{}) resourceDefinitionSet {
+	if config, ok := meta.(*providerConfig); ok && config != nil && config.Definitions != nil {
+		return *config.Definitions
+	}
+	return builtinDefinitionSet
+}
+
+// unquoteDefinitionPattern returns the regular expression of a definition. The patterns
+// of resourceDefinition.json are stored as Go string literals, "..." or `...`, which are
+// unquoted. Any other value is used as is.
+func unquoteDefinitionPattern(pattern string) (string, error) {
+	if len(pattern) >= 2 && (pattern[0] == '"' || pattern[0] == '`') && pattern[len(pattern)-1] == pattern[0] {
+		return strconv.Unquote(pattern)
+	}
+	return pattern, nil
+}
+
+// parseResourceDefinitions reads definitions in the format of resourceDefinition.json.
+func parseResourceDefinitions(content []byte) ([]ResourceStructure, error) {
+	var definitions []ResourceStructure
+	if err := json.Unmarshal(content, &definitions); err != nil {
+		return nil, err
+	}
+	for i, definition := range definitions {
+		var err error
+		if definitions[i].RegEx, err = unquoteDefinitionPattern(definition.RegEx); err != nil {
+			return nil, fmt.Errorf("entry %d (%s): invalid regex literal %s: %w", i, definition.ResourceTypeName, definition.RegEx, err)
+		}
+		if definitions[i].ValidationRegExp, err = unquoteDefinitionPattern(definition.ValidationRegExp); err != nil {
+			return nil, fmt.Errorf("entry %d (%s): invalid validation_regex literal %s: %w", i, definition.ResourceTypeName, definition.ValidationRegExp, err)
+		}
+	}
+	return definitions, nil
+}
+
+// validateResourceDefinition checks a definition against the rules every built-in
+// definition complies with, see resourcedefinition.Validate, and returns the list
+// of violations.
+func validateResourceDefinition(resource ResourceStructure) []string {
+	return resourcedefinition.Validate(resourcedefinition.ResourceStructure{
+		ResourceTypeName: resource.ResourceTypeName,
+		CafPrefix:        resource.CafPrefix,
+		MinLength:        resource.MinLength,
+		MaxLength:        resource.MaxLength,
+		RegEx:            resource.RegEx,
+		ValidationRegExp: resource.ValidationRegExp,
+		Dashes:           resource.Dashes,
+		SlugAliases:      resource.SlugAliases,
+	})
+}
+
+// resourceDefinitionChanges lists the attributes of a built-in definition that differ
+// in its override.
+func resourceDefinitionChanges(builtin ResourceStructure, override ResourceStructure) []string {
+	changes := []string{}
+	compare := func(attribute string, from interface{}, to interface{}) {
+		if from != to {
+			changes = append(changes, fmt.Sprintf("%s: %v -> %v", attribute, from, to))
+		}
+	}
+	compare("slug", builtin.CafPrefix, override.CafPrefix)
+	compare("min_length", builtin.MinLength, override.MinLength)
+	compare("max_length", builtin.MaxLength, override.MaxLength)
+	compare("lowercase", builtin.LowerCase, override.LowerCase)
+	compare("regex", builtin.RegEx, override.RegEx)
+	compare("validation_regex", builtin.ValidationRegExp, override.ValidationRegExp)
+	compare("dashes", builtin.Dashes, override.Dashes)
+	compare("scope", builtin.Scope, override.Scope)
+	compare("slug_aliases", strings.Join(builtin.SlugAliases, ","), strings.Join(override.SlugAliases, ","))
+	compare("canonical", builtin.Canonical, override.Canonical)
+	return changes
+}
+
+// newResourceDefinitionSet returns the built-in definitions extended with the custom
+// ones, see extend.
+func newResourceDefinitionSet(custom []ResourceStructure) (*resourceDefinitionSet, diag.Diagnostics) {
+	return builtinDefinitionSet.extend(custom)
+}
+
+// definitionSetOf returns the set of the given definitions, with their slugs resolved.
+func definitionSetOf(definitions []ResourceStructure) resourceDefinitionSet {
+	set := resourceDefinitionSet{Definitions: make(map[string]ResourceStructure, len(definitions))}
+	for _, resource := range definitions {
+		set.Definitions[resource.ResourceTypeName] = resource
+	}
+	set.Slugs, set.AmbiguousSlugs = resolveSlugs(definitions)
+	set.ArmTypes = armTypeIndex(set.Definitions)
+	return set
+}
+
+// extend returns the definitions of s extended with the custom ones. Invalid custom
+// definitions are reported as errors, while overriding a definition of s or reusing
+// the slug of another of its types is reported as a warning. A reused slug keeps
+// resolving to the type of s, unless the custom definition is canonical.
+func (s resourceDefinitionSet) extend(custom []ResourceStructure) (*resourceDefinitionSet, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	set := &resourceDefinitionSet{
+		Definitions:    make(map[string]ResourceStructure, len(s.Definitions)+len(custom)),
+		Slugs:          make(map[string]string, len(s.Slugs)+len(custom)),
+		AmbiguousSlugs: make(map[string][]string, len(s.AmbiguousSlugs)),
+	}
+	for k, v := range s.Definitions {
+		set.Definitions[k] = v
+	}
+	for k, v := range s.Slugs {
+		set.Slugs[k] = v
+	}
+	for k, v := range s.AmbiguousSlugs {
+		set.AmbiguousSlugs[k] = v
+	}
+
+	seen := map[string]bool{}
+	claimants := []ResourceStructure{}
+	for i, resource := range custom {
+		if violations := validateResourceDefinition(resource); len(violations) > 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Invalid custom resource definition %q", resource.ResourceTypeName),
+				Detail:   fmt.Sprintf("Entry %d does not comply with the resource definition rules:\n  - %s", i, strings.Join(violations, "\n  - ")),
+			})
+			continue
+		}
+		if seen[resource.ResourceTypeName] {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("Duplicate custom resource definition %q", resource.ResourceTypeName),
+				Detail:   fmt.Sprintf("Entry %d defines %s, which is already defined by a previous custom entry.", i, resource.ResourceTypeName),
+			})
+			continue
+		}
+		seen[resource.ResourceTypeName] = true
+
+		if builtin, exists := s.Definitions[resource.ResourceTypeName]; exists {
+			changes := resourceDefinitionChanges(builtin, resource)
+			if len(changes) > 0 {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("Custom resource definition overrides the built-in %q", resource.ResourceTypeName),
+					Detail:   fmt.Sprintf("The names generated for %s use the custom definition:\n  - %s", resource.ResourceTypeName, strings.Join(changes, "\n  - ")),
+				})
+			}
+		}
+		set.Definitions[resource.ResourceTypeName] = resource
+
+		// Every slug kept is claimed on its own, as the primary slug of a copy
+		claimant := resource
+		claimant.SlugAliases = nil
+		for _, slug := range resource.slugs() {
+			if slug == "" {
+				continue
+			}
+			owners := s.slugOwners(slug)
+			if len(owners) > 0 && !resource.Canonical {
+				if !slices.Contains(owners, resource.ResourceTypeName) {
+					diags = append(diags, diag.Diagnostic{
+						Severity: diag.Warning,
+						Summary:  fmt.Sprintf("Custom resource definition %q reuses the built-in slug %q", resource.ResourceTypeName, slug),
+						Detail:   fmt.Sprintf("The slug %s already stands for %s, slug lookups keep resolving to the built-in type. Set canonical to resolve it to %s instead.", slug, strings.Join(owners, ", "), resource.ResourceTypeName),
+					})
+				}
+				continue
+			}
+			if len(owners) > 0 && !slices.Contains(owners, resource.ResourceTypeName) {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  fmt.Sprintf("Canonical custom resource definition %q takes over the built-in slug %q", resource.ResourceTypeName, slug),
+					Detail:   fmt.Sprintf("The slug %s stood for %s, slug lookups now resolve to %s.", slug, strings.Join(owners, ", "), resource.ResourceTypeName),
+				})
+			}
+			claimant.CafPrefix = slug
+			claimants = append(claimants, claimant)
+		}
+	}
+
+	slugs, ambiguousSlugs := resolveSlugs(claimants)
+	for slug, resourceType := range slugs {
+		set.Slugs[slug] = resourceType
+		delete(set.AmbiguousSlugs, slug)
+	}
+	for slug, candidates := range ambiguousSlugs {
+		set.AmbiguousSlugs[slug] = candidates
+		delete(set.Slugs, slug)
+	}
+	set.ArmTypes = armTypeIndex(set.Definitions)
+	return set, diags
+}
+
+// loadCustomResourceDefinitions reads the custom definitions from a file and from an
+// inline JSON document, in that order.
+func loadCustomResourceDefinitions(path string, inline string) ([]ResourceStructure, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	definitions := []ResourceStructure{}
+
+	if path != "" {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			diags = append(diags, diag.Errorf("reading resource_definitions_file: %s", err)...)
+		} else if fileDefinitions, err := parseResourceDefinitions(content); err != nil {
+			diags = append(diags, diag.Errorf("parsing resource_definitions_file %s: %s", path, err)...)
+		} else {
+			definitions = append(definitions, fileDefinitions...)
+		}
+	}
+	if inline != "" {
+		inlineDefinitions, err := parseResourceDefinitions([]byte(inline))
+		if err != nil {
+			diags = append(diags, diag.Errorf("parsing resource_definitions: %s", err)...)
+		} else {
+			definitions = append(definitions, inlineDefinitions...)
+		}
+	}
+	return definitions, diags
+}
@@ -0,0 +1,328 @@
+package azurecaf
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const testCustomResourceDefinitions = `[
+  {
+    "name": "azurerm_contoso_widget",
+    "min_length": 3,
+    "max_length": 12,
+    "validation_regex": "\"^[a-z0-9]{3,12}$\"",
+    "scope": "resourceGroup",
+    "slug": "cwid",
+    "dashes": false,
+    "lowercase": true,
+    "regex": "\"[^0-9a-z]\""
+  }
+]`
+
+func TestBuiltinResourceDefinitionsAreValid(t *testing.T) {
+	for name, resource := range ResourceDefinitions {
+		if violations := validateResourceDefinition(resource); len(violations) > 0 {
+			t.Errorf("built-in definition %s is invalid: %v", name, violations)
+		}
+	}
+}
+
+func TestParseResourceDefinitions(t *testing.T) {
+	definitions, err := parseResourceDefinitions([]byte(testCustomResourceDefinitions))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(definitions) != 1 {
+		t.Fatalf("expected 1 definition, got %d", len(definitions))
+	}
+	if definitions[0].ValidationRegExp != "^[a-z0-9]{3,12}$" {
+		t.Errorf("expected the validation regex to be unquoted, got %s", definitions[0].ValidationRegExp)
+	}
+	if definitions[0].RegEx != "[^0-9a-z]" {
+		t.Errorf("expected the regex to be unquoted, got %s", definitions[0].RegEx)
+	}
+
+	if _, err := parseResourceDefinitions([]byte(`{"name": "azurerm_contoso_widget"}`)); err == nil {
+		t.Error("expected an error for a document which is not a list")
+	}
+}
+
+func TestNewResourceDefinitionSet(t *testing.T) {
+	t.Run("custom type", func(t *testing.T) {
+		custom, _ := parseResourceDefinitions([]byte(testCustomResourceDefinitions))
+		set, diags := newResourceDefinitionSet(custom)
+		if len(diags) > 0 {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		for _, key := range []string{"azurerm_contoso_widget", "cwid"} {
+			resource, err := set.getResource(key)
+			if err != nil {
+				t.Fatalf("expected %s to resolve, got %v", key, err)
+			}
+			if resource.ResourceTypeName != "azurerm_contoso_widget" {
+				t.Errorf("expected %s to resolve to azurerm_contoso_widget, got %s", key, resource.ResourceTypeName)
+			}
+		}
+		if _, exists := ResourceDefinitions["azurerm_contoso_widget"]; exists {
+			t.Error("custom definitions must not leak into the built-in definitions")
+		}
+	})
+
+	t.Run("override", func(t *testing.T) {
+		override := ResourceDefinitions["azurerm_storage_account"]
+		override.MaxLength = 20
+		override.ValidationRegExp = "^[a-z0-9]{3,20}$"
+		set, diags := newResourceDefinitionSet([]ResourceStructure{override})
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+		if len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Detail, "max_length: 24 -> 20") {
+			t.Errorf("expected a warning listing the overridden attributes, got %v", diags)
+		}
+		resource, _ := set.getResource("st")
+		if resource.MaxLength != 20 {
+			t.Errorf("expected the override to be used, got max_length %d", resource.MaxLength)
+		}
+	})
+
+	t.Run("invalid and duplicate entries", func(t *testing.T) {
+		custom, _ := parseResourceDefinitions([]byte(testCustomResourceDefinitions))
+		invalid := custom[0]
+		invalid.ResourceTypeName = "azurerm_contoso_broken"
+		invalid.ValidationRegExp = "^([a-z0-9]{3,12}$"
+		_, diags := newResourceDefinitionSet([]ResourceStructure{custom[0], custom[0], invalid})
+		if len(diags) != 2 {
+			t.Fatalf("expected 2 diagnostics, got %v", diags)
+		}
+		if !strings.Contains(diags[0].Summary, "Duplicate") {
+			t.Errorf("expected a duplicate error, got %s", diags[0].Summary)
+		}
+		if !strings.Contains(diags[1].Detail, "does not compile") {
+			t.Errorf("expected a compile error, got %s", diags[1].Detail)
+		}
+	})
+
+	t.Run("built-in slug", func(t *testing.T) {
+		custom, _ := parseResourceDefinitions([]byte(testCustomResourceDefinitions))
+		custom[0].CafPrefix = "st"
+		set, diags := newResourceDefinitionSet(custom)
+		if diags.HasError() || len(diags) != 1 {
+			t.Fatalf("expected a single warning, got %v", diags)
+		}
+		resource, _ := set.getResource("st")
+		if resource.ResourceTypeName != "azurerm_storage_account" {
+			t.Errorf("expected the built-in slug to be kept, got %s", resource.ResourceTypeName)
+		}
+	})
+}
+
+func TestProviderConfigure_ResourceDefinitions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "definitions.json")
+	if err := os.WriteFile(path, []byte(testCustomResourceDefinitions), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, raw := range map[string]map[string]interface{}{
+		"file":   {"resource_definitions_file": path},
+		"inline": {"resource_definitions": testCustomResourceDefinitions},
+	} {
+		t.Run(name, func(t *testing.T) {
+			meta := testProviderMeta(t, raw)
+
+			d := schema.TestResourceDataRaw(t, resourceName().Schema, map[string]interface{}{
+				"name":          "demo app",
+				"resource_type": "azurerm_contoso_widget",
+			})
+			if _, err := getNameResult(d, meta); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result := d.Get("result").(string); result != "cwiddemoapp" {
+				t.Errorf("expected cwiddemoapp, got %s", result)
+			}
+		})
+	}
+
+	t.Run("missing file", func(t *testing.T) {
+		provider := Provider()
+		diags := provider.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+			"resource_definitions_file": filepath.Join(t.TempDir(), "missing.json"),
+		}))
+		if !diags.HasError() {
+			t.Fatal("expected an error for a missing file")
+		}
+	})
+}
+
+func TestOutOfDocSlugs(t *testing.T) {
+	outOfDoc := builtinDefinitionSet.outOfDocSlugs([]string{"azurerm_storage_account", "azurerm_dns_a_record", "azurerm_dns_a_record", "unknown"}, true)
+	if len(outOfDoc) != 1 || outOfDoc[0].ResourceTypeName != "azurerm_dns_a_record" {
+		t.Fatalf("expected azurerm_dns_a_record only, got %v", outOfDoc)
+	}
+	warnings := outOfDocSlugWarnings(outOfDoc)
+	if len(warnings) != 1 || warnings[0].Severity != diag.Warning || !strings.Contains(warnings[0].Summary, `"dnsrec"`) {
+		t.Errorf("unexpected warnings %v", warnings)
+	}
+	if without := builtinDefinitionSet.outOfDocSlugs([]string{"azurerm_dns_a_record"}, false); len(without) != 0 {
+		t.Errorf("expected no out-of-doc slug when the slug is not used, got %v", without)
+	}
+}
+
+func TestSlugIsOfficial(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataName().Schema, map[string]interface{}{
+		"name":          "contoso",
+		"resource_type": "azurerm_dns_a_record",
+	})
+	diags := dataNameRead(context.Background(), d, nil)
+	if diags.HasError() || len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+	if d.Get("slug_is_official").(bool) {
+		t.Error("expected slug_is_official to be false")
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceName().Schema, map[string]interface{}{
+		"name":           "contoso",
+		"resource_type":  "azurerm_storage_account",
+		"resource_types": []interface{}{"azurerm_resource_group"},
+	})
+	if _, err := getNameResult(d, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !d.Get("slug_is_official").(bool) {
+		t.Error("expected slug_is_official to be true")
+	}
+}
+
+func TestResolveSlugs_MatchesGeneratedMaps(t *testing.T) {
+	slugs, ambiguousSlugs := resolveSlugs(builtinDefinitionSet.sorted())
+	if !reflect.DeepEqual(slugs, ResourceMaps) {
+		t.Error("expected the resolved slugs to match ResourceMaps, run go generate")
+	}
+	if !reflect.DeepEqual(ambiguousSlugs, AmbiguousSlugs) {
+		t.Errorf("expected the ambiguous slugs to match AmbiguousSlugs, got %v", ambiguousSlugs)
+	}
+}
+
+func TestGetResource_SharedSlugs(t *testing.T) {
+	cases := []struct {
+		key      string
+		expected string
+		err      string
+	}{
+		{"apim", "azurerm_api_management", ""},
+		{"sql", "azurerm_mssql_server", ""},
+		{"vml", "azurerm_linux_virtual_machine", ""},
+		{"vmw", "azurerm_windows_virtual_machine", ""},
+		{"vm", "", "slug vm is ambiguous, it matches the resource types: azurerm_linux_virtual_machine, azurerm_virtual_machine, azurerm_virtual_machine_portal_name, azurerm_windows_virtual_machine"},
+	}
+	for _, tt := range cases {
+		t.Run(tt.key, func(t *testing.T) {
+			resource, err := getResource(tt.key)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("expected an error containing %q, got %v", tt.err, err)
+				}
+				if _, err := builtinDefinitionSet.getResourceBySlug(tt.key); err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Errorf("expected the slug lookup to fail with %q, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if resource.ResourceTypeName != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, resource.ResourceTypeName)
+			}
+		})
+	}
+}
+
+func TestNewResourceDefinitionSet_CanonicalSlugs(t *testing.T) {
+	custom, _ := parseResourceDefinitions([]byte(testCustomResourceDefinitions))
+	custom[0].SlugAliases = []string{"vm", "cw"}
+	custom[0].Canonical = true
+	other := custom[0]
+	other.ResourceTypeName = "azurerm_contoso_gadget"
+	other.CafPrefix = "cgad"
+	other.SlugAliases = []string{"cw"}
+	other.Canonical = false
+
+	set, diags := newResourceDefinitionSet([]ResourceStructure{custom[0], other})
+	if diags.HasError() || len(diags) != 1 || !strings.Contains(diags[0].Summary, `takes over the built-in slug "vm"`) {
+		t.Fatalf("expected a single take over warning, got %v", diags)
+	}
+	for _, key := range []string{"vm", "cw", "cwid"} {
+		resource, err := set.getResource(key)
+		if err != nil || resource.ResourceTypeName != "azurerm_contoso_widget" {
+			t.Errorf("expected %s to resolve to the canonical custom type, got %v, %v", key, resource, err)
+		}
+	}
+	if _, err := builtinDefinitionSet.getResource("vm"); err == nil {
+		t.Error("custom slugs must not leak into the built-in definitions")
+	}
+
+	other.Canonical = true
+	custom[0].SlugAliases = []string{"cw"}
+	set, _ = newResourceDefinitionSet([]ResourceStructure{custom[0], other})
+	if _, err := set.getResource("cw"); err == nil || !strings.Contains(err.Error(), "azurerm_contoso_gadget, azurerm_contoso_widget") {
+		t.Errorf("expected the alias claimed by two canonical types to be ambiguous, got %v", err)
+	}
+}
+
+func TestGetResource_ArmTypes(t *testing.T) {
+	cases := []struct {
+		key      string
+		expected string
+		err      string
+	}{
+		{"Microsoft.KeyVault/vaults", "azurerm_key_vault", ""},
+		{"microsoft.keyvault/VAULTS", "azurerm_key_vault", ""},
+		{"Microsoft.Storage/storageAccounts", "azurerm_storage_account", ""},
+		// Shared ARM types resolve to their canonical resource type
+		{"Microsoft.ApiManagement/service", "azurerm_api_management", ""},
+		{"Microsoft.Sql/servers", "azurerm_mssql_server", ""},
+		{"Microsoft.DigitalTwins/digitalTwinsInstances", "azurerm_digital_twins_instance", ""},
+		{"Microsoft.Compute/virtualMachines", "", "ARM type Microsoft.Compute/virtualMachines is ambiguous, it matches the resource types: azurerm_linux_virtual_machine, azurerm_virtual_machine"},
+		{"Microsoft.Contoso/widgets", "", "no resource type has the ARM type Microsoft.Contoso/widgets"},
+	}
+	for _, tt := range cases {
+		t.Run(tt.key, func(t *testing.T) {
+			resource, err := getResource(tt.key)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("expected an error containing %q, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if resource.ResourceTypeName != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, resource.ResourceTypeName)
+			}
+		})
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceName().Schema, map[string]interface{}{
+		"name":           "contoso",
+		"resource_type":  "Microsoft.KeyVault/vaults",
+		"resource_types": []interface{}{"microsoft.resources/resourcegroups"},
+	})
+	if _, err := getNameResult(d, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result := d.Get("result").(string); result != "kv-contoso" {
+		t.Errorf("expected kv-contoso, got %s", result)
+	}
+	if results := d.Get("results").(map[string]interface{}); results["microsoft.resources/resourcegroups"] != "rg-contoso" {
+		t.Errorf("expected the results to be keyed by the ARM type, got %v", results)
+	}
+}
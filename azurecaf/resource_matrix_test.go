@@ -1,6 +1,7 @@
 package azurecaf
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
@@ -89,7 +90,7 @@ func TestResourceMatrix(t *testing.T) {
 						"clean_input":   true,
 					})
 
-					err := nameResource.Create(resourceData, nil)
+					err := diagnosticsError(nameResource.CreateContext(context.Background(), resourceData, nil))
 					if err != nil {
 						t.Errorf("Failed for %s: %v", resourceType, err)
 						return
@@ -3,10 +3,12 @@ package azurecaf
 import (
 	"context"
 	"fmt"
+	"math"
 	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -128,6 +130,37 @@ func resourceNameV2() *schema.Resource {
 	}
 }
 
+// resourceNameCustomizeDiff checks the resource types against the definitions of the
+// provider, which include the custom definitions unknown when the schema is validated.
+func resourceNameCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("resource_type") || !d.NewValueKnown("resource_types") || !d.NewValueKnown("rules_version") {
+		return nil
+	}
+	resourceType := d.Get("resource_type").(string)
+	resourceTypes := convertInterfaceToString(d.Get("resource_types").([]interface{}))
+	if resourceType == "" && len(resourceTypes) == 0 {
+		return nil
+	}
+	definitions, err := definitionsFor(meta, d.Get("rules_version").(string))
+	if err != nil {
+		return err
+	}
+	if _, err := definitions.validateResourceType(resourceType, resourceTypes); err != nil {
+		return err
+	}
+	if !d.NewValueKnown("case") {
+		return nil
+	}
+	for _, resourceTypeName := range append(resourceTypes, resourceType) {
+		if resource, err := definitions.getResource(resourceTypeName); err == nil {
+			if err := checkNameCase(resource, d.Get("case").(string)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func resourceNameStateUpgradeV2(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
 	rawState["use_slug"] = true
 
@@ -135,14 +168,9 @@ func resourceNameStateUpgradeV2(ctx context.Context, rawState map[string]interfa
 }
 
 func resourceName() *schema.Resource {
-	resourceMapsKeys := make([]string, 0, len(ResourceDefinitions))
-	for k := range ResourceDefinitions {
-		resourceMapsKeys = append(resourceMapsKeys, k)
-	}
-
 	return &schema.Resource{
-		Create:        resourceNameCreate,
-		Read:          schema.Noop,
+		CreateContext: resourceNameCreate,
+		ReadContext:   schema.NoopContext,
 		Delete:        schema.RemoveFromState,
 		SchemaVersion: 3,
 		StateUpgraders: []schema.StateUpgrader{
@@ -155,6 +183,8 @@ func resourceName() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceNameImport,
 		},
+		CustomizeDiff:                  resourceNameCustomizeDiff,
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{resourceNameValidateSlugs},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -205,6 +235,11 @@ func resourceName() *schema.Resource {
 				Computed:    true,
 				Description: "Map of generated names keyed by resource type, for each type specified in resource_types.",
 			},
+			"slug_is_official": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether every slug used in the generated names comes from the official CAF documentation. A warning is raised for the out-of-doc slugs.",
+			},
 			"separator": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -212,6 +247,26 @@ func resourceName() *schema.Resource {
 				Default:     "-",
 				Description: "Separator character used between name components (default: \"-\").",
 			},
+			"padding": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(paddingModes, false),
+				Description:  "Characters appended to the names shorter than the minimum length of their resource type and refused by its validation regex: hash (default) appends a hash of the name, random appends random characters, seeded by random_seed, none refuses the names too short. The characters are the lowercase letters and digits allowed by the resource type.",
+			},
+			"adapt_separator": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "When true, the names of the resource types which do not allow the separator use a dash when they allow dashes, an underscore when they allow underscores, or no separator, instead of running the words together.",
+			},
+			"separators": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Separator of the names of some resource types, keyed by resource type, e.g. { azurerm_storage_account = \"\" }. Overrides separator and adapt_separator for these resource types, which must be resource_type or among resource_types.",
+			},
 			"clean_input": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -219,6 +274,39 @@ func resourceName() *schema.Resource {
 				Default:     true,
 				Description: "Whether to remove characters that are not allowed by the Azure resource naming rules (default: true).",
 			},
+			"transliterate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Whether to replace the accented and other non-ASCII letters of the inputs with their ASCII spelling before the characters not allowed by the resource type are removed, e.g. \"Société-Générale\" becomes \"Societe-Generale\" instead of \"Socit-Gnrale\". Only applies with clean_input.",
+			},
+			"repairs": {
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Computed:    true,
+				Description: "Changes made to the ends of the names to follow the rules of the resource types, e.g. a leading digit removed for a resource type whose names start with a letter, or a trailing separator left by the truncation.",
+			},
+			"cleaned_characters": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Characters of the inputs replaced or removed by clean_input and transliterate, one element for resource_type then one for each of resource_types.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Resource type of the name, as set in resource_type or resource_types.",
+						},
+						"characters": {
+							Type:        schema.TypeMap,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Computed:    true,
+							Description: "Characters replaced or removed by the naming rules of the resource type, mapped to their replacement, empty when removed.",
+						},
+					},
+				},
+			},
+			"composition": compositionSchema("How the names were composed, one element for resource_type then one for each of resource_types."),
 			"passthrough": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -229,15 +317,15 @@ func resourceName() *schema.Resource {
 			"resource_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validation.StringInSlice(resourceMapsKeys, false),
+				ValidateFunc: validation.StringIsNotEmpty,
 				ForceNew:     true,
-				Description:  "Azure resource type for name generation (e.g., \"azurerm_storage_account\"). The result is stored in the result attribute.",
+				Description:  "Azure resource type for name generation (e.g., \"azurerm_storage_account\"), its CAF slug or its ARM resource type (e.g., \"Microsoft.Storage/storageAccounts\"). The result is stored in the result attribute.",
 			},
 			"resource_types": {
 				Type: schema.TypeList,
 				Elem: &schema.Schema{
 					Type:         schema.TypeString,
-					ValidateFunc: validation.StringInSlice(resourceMapsKeys, false),
+					ValidateFunc: validation.StringIsNotEmpty,
 				},
 				Optional:    true,
 				ForceNew:    true,
@@ -256,6 +344,76 @@ func resourceName() *schema.Resource {
 				Default:     true,
 				Description: "Whether to include the CAF resource type slug/abbreviation in the generated name (default: true).",
 			},
+			"profile": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				Description:  "Name of a profile defined in the provider configuration. Its values, merged with the provider defaults, are used for the arguments that are not set on this resource.",
+			},
+			"random_character_set": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(randomCharacterSets, false),
+				Description:  "Characters of the random segment: lowercase (default), letters, alphanumeric or numeric. Uppercase letters are only generated for the resource types which are not lowercase, and the characters not allowed by the resource type are left out.",
+			},
+			"hash_inputs": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"hash_length"},
+				Description:  "Values digested into the hash segment, e.g. the subscription id and the environment. The same values always give the same hash.",
+			},
+			"hash_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				RequiredWith: []string{"hash_inputs"},
+				Description:  "Number of characters of the hash segment, made of the lowercase letters and digits allowed by the resource type.",
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateNameFormat,
+				Description:  "Template laying out the name with placeholders, e.g. \"{env}{slug}{workload}{region?}{instance?|}\". The built-in placeholders are name, slug, random, prefixes and suffixes, the other ones are read from format_values. A placeholder followed by ? is optional, a placeholder followed by |<separator> is joined to the previous segment with that separator.",
+			},
+			"format_values": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Values of the custom placeholders of the format.",
+			},
+			"segment_priorities": {
+				Type:        schema.TypeMap,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Priority of the placeholders, e.g. { prefixes = 10 }. When the name exceeds the maximum length, the segments of the lowest priority are dropped first. Placeholders without priority have priority 0 and keep their default order.",
+			},
+			"required_segments": {
+				Type: schema.TypeList,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(placeholderKeyRegEx, "must be a placeholder key"),
+				},
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Placeholders whose segments are always part of the name. An error is returned when they have no value or when they alone exceed the maximum length.",
+			},
+			"shortening": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(shorteningModes, false),
+				Description:  "Strategy applied when the name exceeds the maximum length: truncate (default) drops the segments which do not fit, proportional shortens the name, prefixes, suffixes and custom segments proportionally to their length, vowels removes the vowels of the name, hash replaces the overflowing characters with a 5 characters hash of the whole name.",
+			},
 			"error_when_exceeding_max_length": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -263,16 +421,61 @@ func resourceName() *schema.Resource {
 				Default:     false,
 				Description: "When true, returns an error if the generated name exceeds the resource type's maximum length instead of truncating it.",
 			},
+			"uniqueness_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(uniquenessPolicies, false),
+				Description:  "Policy applied to the resource types of global scope, e.g. storage accounts, when the random and hash segments of the name carry too little entropy: off (default), warn or fail. Overrides the uniqueness_policy of the provider defaults.",
+			},
+			"case": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(nameCases, false),
+				Description:  "Case of the name: preserve (default) keeps the case of the inputs, lower, upper, pascal capitalizes the words of the prefixes, slug, name, suffixes and custom segments and removes the characters between them, camel does the same but starts with a lowercase letter. The resource types whose naming rules do not allow the letters of the case are refused, e.g. upper for storage accounts. The names of the lowercase resource types are always lowercased.",
+			},
+			"rules_version": rulesVersionSchema(true, "Version of the built-in naming rules used to generate the name, e.g. v1, or latest. Overrides the rules_version of the provider."),
+			"collision_probability": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Estimated probability that another name generated from the same inputs is identical, the highest among resource_type and resource_types, based on the length and the character set of the random and hash segments.",
+			},
 		},
 	}
 }
 
-func resourceNameCreate(d *schema.ResourceData, meta interface{}) error {
-	return resourceNameRead(d, meta)
+func resourceNameCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags, err := getNameResult(d, meta)
+	if err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	return diags
 }
 
-func resourceNameRead(d *schema.ResourceData, meta interface{}) error {
-	return getNameResult(d, meta)
+// resourceNameValidateSlugs warns about the out-of-doc slugs used in the names. The
+// provider is not configured at validation time, so only the built-in definitions
+// are checked.
+func resourceNameValidateSlugs(ctx context.Context, req schema.ValidateResourceConfigFuncRequest, resp *schema.ValidateResourceConfigFuncResponse) {
+	config := req.RawConfig
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() {
+		return
+	}
+	if useSlug := config.GetAttr("use_slug"); !useSlug.IsKnown() || (!useSlug.IsNull() && useSlug.False()) {
+		return
+	}
+	resourceTypes := []string{}
+	if resourceType := config.GetAttr("resource_type"); resourceType.IsKnown() && !resourceType.IsNull() {
+		resourceTypes = append(resourceTypes, resourceType.AsString())
+	}
+	if list := config.GetAttr("resource_types"); list.IsKnown() && !list.IsNull() {
+		for _, resourceType := range list.AsValueSlice() {
+			if resourceType.IsKnown() && !resourceType.IsNull() {
+				resourceTypes = append(resourceTypes, resourceType.AsString())
+			}
+		}
+	}
+	resp.Diagnostics = outOfDocSlugWarnings(builtinDefinitionSet.outOfDocSlugs(resourceTypes, true))
 }
 
 func resourceNameDelete(d *schema.ResourceData, meta interface{}) error {
@@ -295,7 +498,7 @@ func resourceNameImport(d *schema.ResourceData, meta interface{}) ([]*schema.Res
 	existingName := parts[1]
 
 	// Validate the resource type exists
-	resource, err := getResource(resourceType)
+	resource, err := definitionsFromMeta(meta).getResource(resourceType)
 	if err != nil {
 		return nil, fmt.Errorf("unsupported resource type '%s': %w", resourceType, err)
 	}
@@ -365,13 +568,7 @@ func concatenateParameters(separator string, parameters ...[]string) string {
 }
 
 func getResource(resourceType string) (*ResourceStructure, error) {
-	if resourceKey, existing := ResourceMaps[resourceType]; existing {
-		resourceType = resourceKey
-	}
-	if resource, resourceFound := ResourceDefinitions[resourceType]; resourceFound {
-		return &resource, nil
-	}
-	return nil, fmt.Errorf("invalid resource type %s", resourceType)
+	return builtinDefinitionSet.getResource(resourceType)
 }
 
 // Retrieve the resource slug / shortname based on the resourceType and the selected convention
@@ -402,6 +599,22 @@ func convertInterfaceToString(source []interface{}) []string {
 	return s
 }
 
+func convertInterfaceMapToString(source map[string]interface{}) map[string]string {
+	s := make(map[string]string, len(source))
+	for k, v := range source {
+		s[k] = fmt.Sprint(v)
+	}
+	return s
+}
+
+func convertInterfaceMapToInt(source map[string]interface{}) map[string]int {
+	s := make(map[string]int, len(source))
+	for k, v := range source {
+		s[k] = v.(int)
+	}
+	return s
+}
+
 func composeName(separator string,
 	prefixes []string,
 	name string,
@@ -411,57 +624,21 @@ func composeName(separator string,
 	maxlength int,
 	namePrecedence []string,
 	errorWhenExceedingMaxLength bool) (string, error) {
-	nameBuilder := NewNameBuilder(maxlength, separator)
-
-	for i := 0; i < len(namePrecedence); i++ {
-		switch c := namePrecedence[i]; c {
-		case "name":
-			if len(name) > 0 {
-				nameBuilder.Append(name)
-			}
-		case "slug":
-			if len(slug) > 0 {
-				nameBuilder.Prepend(slug)
-			}
-		case "random":
-			if len(randomSuffix) > 0 {
-				nameBuilder.Append(randomSuffix)
-			}
-		case "suffixes":
-			if len(suffixes) > 0 {
-				if len(suffixes[0]) > 0 {
-					nameBuilder.Append(suffixes[0])
-				}
-				suffixes = suffixes[1:]
-				if len(suffixes) > 0 {
-					i--
-				}
-			}
-		case "prefixes":
-			if len(prefixes) > 0 {
-				if len(prefixes[len(prefixes)-1]) > 0 {
-					nameBuilder.Prepend(prefixes[len(prefixes)-1])
-				}
-				prefixes = prefixes[:len(prefixes)-1]
-				if len(prefixes) > 0 {
-					i--
-				}
-			}
-		}
-	}
-	if errorWhenExceedingMaxLength {
-		content := nameBuilder.GetName()
-		contentLength := len(content)
-		if contentLength > maxlength {
-			return "", fmt.Errorf("composed name '%s' exceeds maximum length of %d by %d characters", content, maxlength, contentLength-maxlength)
-		}
-		return content, nil
-	}
-	content := nameBuilder.GetTrimmedName()
-	return content, nil
+	values := map[string][]string{
+		PlaceholderName:     {name},
+		PlaceholderSlug:     {slug},
+		PlaceholderRandom:   {randomSuffix},
+		PlaceholderPrefixes: prefixes,
+		PlaceholderSuffixes: suffixes,
+	}
+	return composeFormattedName(legacyNameFormat(namePrecedence), values, separator, maxlength, nameShortening{}, errorWhenExceedingMaxLength)
 }
 
 func validateResourceType(resourceType string, resourceTypes []string) (bool, error) {
+	return builtinDefinitionSet.validateResourceType(resourceType, resourceTypes)
+}
+
+func (s resourceDefinitionSet) validateResourceType(resourceType string, resourceTypes []string) (bool, error) {
 	isEmpty := len(resourceType) == 0 && len(resourceTypes) == 0
 	if isEmpty {
 		return false, fmt.Errorf("resource_type and resource_types parameters are empty, you must specify at least one resource type")
@@ -473,7 +650,7 @@ func validateResourceType(resourceType string, resourceTypes []string) (bool, er
 	}
 
 	for _, resource := range resourceList {
-		_, err := getResource(resource)
+		_, err := s.getResource(resource)
 		if err != nil {
 			errorStrings = append(errorStrings, err.Error())
 		}
@@ -500,102 +677,303 @@ func getResourceName(resourceTypeName string, separator string,
 	if err != nil {
 		return "", err
 	}
-	validationRegEx, err := regexp.Compile(resource.ValidationRegExp)
+	inputs := nameInputs{
+		Name:                        name,
+		Prefixes:                    prefixes,
+		Suffixes:                    suffixes,
+		Separator:                   separator,
+		CleanInput:                  cleanInput,
+		Passthrough:                 passthrough,
+		UseSlug:                     useSlug,
+		ErrorWhenExceedingMaxLength: errorWhenExceedingMaxLength,
+		Format:                      legacyNameFormat(namePrecedence),
+	}
+	return getResourceNameForDefinition(resource, inputs, randomSuffix, convention)
+}
+
+// randomSuffixFor encodes the random values, shared by every resource type of a
+// name, with the characters of the character set allowed by the resource type.
+func randomSuffixFor(resource *ResourceStructure, characterSet string, values []uint64) (string, error) {
+	if len(values) == 0 {
+		return "", nil
+	}
+	alphabet, err := randomCharacters(characterSet, resource)
 	if err != nil {
 		return "", err
 	}
+	return encodeRandomValues(values, alphabet), nil
+}
+
+// getResourceNameForDefinition generates the name of a resource whose definition has
+// already been resolved, either from the built-in or the custom definitions. The name
+// is laid out by inputs.Format, or by the historical composition when it is nil.
+func getResourceNameForDefinition(resource *ResourceStructure, inputs nameInputs, randomSuffix string, convention string) (string, error) {
+	generated, err := generateResourceName(resource, inputs, randomSuffix, convention)
+	return generated.Name, err
+}
+
+// generatedName is a generated name and the report of its generation.
+type generatedName struct {
+	Name string
+	// Repairs describe the changes made to the ends of the name to follow the rules
+	// of the resource type
+	Repairs []string
+	// Composition describes the segments the name was composed of
+	Composition nameComposition
+}
+
+// generateResourceName generates the name of a resource like
+// getResourceNameForDefinition, and reports how the name was composed and repaired.
+func generateResourceName(resource *ResourceStructure, inputs nameInputs, randomSuffix string, convention string) (generatedName, error) {
+	generated := generatedName{Repairs: []string{}, Composition: nameComposition{MaxLength: resource.MaxLength, Segments: []compositionSegment{}}}
+	name := inputs.Name
+	prefixes := inputs.Prefixes
+	suffixes := inputs.Suffixes
+	separator := separatorFor(resource, inputs)
+	format := inputs.Format
+	if format == nil {
+		format = legacyNameFormat(defaultNamePrecedence)
+	}
+	if len(inputs.SegmentPriorities) > 0 || len(inputs.RequiredSegments) > 0 {
+		var err error
+		format, err = format.withSegmentRules(inputs.SegmentPriorities, inputs.RequiredSegments)
+		if err != nil {
+			return generated, err
+		}
+	}
+	formatValues := make(map[string]string, len(inputs.FormatValues))
+	for k, v := range inputs.FormatValues {
+		formatValues[k] = v
+	}
+
+	validationRegEx, err := regexp.Compile(resource.ValidationRegExp)
+	if err != nil {
+		return generated, err
+	}
+	if err := checkNameCase(resource, inputs.Case); err != nil {
+		return generated, err
+	}
 
 	slug := ""
-	if useSlug {
-		slug = getSlug(resourceTypeName, convention)
+	if inputs.UseSlug && (convention == ConventionCafClassic || convention == ConventionCafRandom) {
+		slug = resource.CafPrefix
+	}
+
+	hash := hashSeq(inputs.HashInputs, inputs.HashLength, resource)
+	originals := map[string][]string{
+		PlaceholderName:     {name},
+		PlaceholderSlug:     {slug},
+		PlaceholderRandom:   {randomSuffix},
+		PlaceholderHash:     {hash},
+		PlaceholderPrefixes: prefixes,
+		PlaceholderSuffixes: suffixes,
+	}
+	for k, v := range formatValues {
+		originals[k] = []string{v}
+	}
+
+	prefixes = caseSegments(prefixes, inputs.Case)
+	suffixes = caseSegments(suffixes, inputs.Case)
+	name = caseSegment(name, inputs.Case)
+	slug = caseSegment(slug, inputs.Case)
+	for k, v := range formatValues {
+		formatValues[k] = caseSegment(v, inputs.Case)
+	}
+
+	if inputs.CleanInput && inputs.Transliterate {
+		prefixes = transliterateSlice(prefixes)
+		suffixes = transliterateSlice(suffixes)
+		name = transliterate(name)
+		separator = transliterate(separator)
+		for k, v := range formatValues {
+			formatValues[k] = transliterate(v)
+		}
 	}
 
-	if cleanInput {
+	if inputs.CleanInput {
 		prefixes = cleanSlice(prefixes, resource)
 		suffixes = cleanSlice(suffixes, resource)
 		name = cleanString(name, resource)
 		separator = cleanString(separator, resource)
 		randomSuffix = cleanString(randomSuffix, resource)
+		for k, v := range formatValues {
+			formatValues[k] = cleanString(v, resource)
+		}
+		format = format.withSeparators(func(s string) string { return cleanString(s, resource) })
 	}
 
 	var resourceName string
+	// truncated tells whether the name was cut to fit the maximum length
+	truncated := false
 
-	if passthrough {
+	if inputs.Passthrough {
 		resourceName = name
+		generated.Composition.Segments = append(generated.Composition.Segments, compositionSegment{Kind: PlaceholderName, Original: inputs.Name, Cleaned: name, Included: true})
 	} else {
-		resourceName, err = composeName(separator, prefixes, name, slug, suffixes, randomSuffix, resource.MaxLength, namePrecedence, errorWhenExceedingMaxLength)
+		values := map[string][]string{
+			PlaceholderName:     {name},
+			PlaceholderSlug:     {slug},
+			PlaceholderRandom:   {randomSuffix},
+			PlaceholderHash:     {hash},
+			PlaceholderPrefixes: prefixes,
+			PlaceholderSuffixes: suffixes,
+		}
+		for k, v := range formatValues {
+			values[k] = []string{v}
+		}
+		shortening := nameShortening{Mode: inputs.Shortening, Alphabet: allowedCharacters(resource, hashgenerator)}
+		var segments []NameSegment
+		resourceName, segments, err = composeFormattedSegments(format, values, separator, resource.MaxLength, shortening, inputs.ErrorWhenExceedingMaxLength)
 		if err != nil {
-			return "", err
+			return generated, err
 		}
+		generated.Composition.Segments = composedSegments(format, originals, values, segments)
+		full, _, _ := composeFormattedSegments(format, values, separator, math.MaxInt, nameShortening{}, false)
+		truncated = len(full) > resource.MaxLength
 	}
 	resourceName = trimResourceName(resourceName, resource.MaxLength)
+	resourceName = caseName(resourceName, inputs.Case)
 
 	if resource.LowerCase {
 		resourceName = strings.ToLower(resourceName)
 	}
 
+	// Passthrough names are only validated, the generated ones are repaired
+	if !inputs.Passthrough {
+		var repairs []string
+		if truncated {
+			resourceName, repairs = trimTrailingSeparators(resource, validationRegEx, resourceName, separator)
+			generated.Repairs = append(generated.Repairs, repairs...)
+		}
+		if !validationRegEx.MatchString(resourceName) {
+			if repaired, repairs := repairName(resource, resourceName); repaired != "" {
+				resourceName = repaired
+				generated.Repairs = append(generated.Repairs, repairs...)
+			}
+		}
+		// Only the names refused by the naming rules are padded, the regex of some
+		// resource types accepts names shorter than their minimum length
+		if !validationRegEx.MatchString(resourceName) {
+			resourceName, repairs = padName(resource, resourceName, inputs.Padding, inputs.RandomSeed)
+			generated.Repairs = append(generated.Repairs, repairs...)
+		}
+	}
+
 	if !validationRegEx.MatchString(resourceName) {
-		return "", fmt.Errorf("invalid name for CAF naming %s %s, the pattern %s doesn't match %s", resource.ResourceTypeName, name, resource.ValidationRegExp, resourceName)
+		if resourceName == "" {
+			return generated, fmt.Errorf("invalid name for CAF naming %s %s, the name is empty", resource.ResourceTypeName, name)
+		}
+		if len(resourceName) < resource.MinLength {
+			return generated, fmt.Errorf("invalid name for CAF naming %s %s, %s is too short: %d < %d", resource.ResourceTypeName, name, resourceName, len(resourceName), resource.MinLength)
+		}
+		return generated, fmt.Errorf("invalid name for CAF naming %s %s, the pattern %s doesn't match %s", resource.ResourceTypeName, name, resource.ValidationRegExp, resourceName)
 	}
 
-	return resourceName, nil
+	generated.Name = resourceName
+	generated.Composition.RemainingLength = resource.MaxLength - len(resourceName)
+	return generated, nil
 }
 
-func getNameResult(d *schema.ResourceData, meta interface{}) error {
-	name := d.Get("name").(string)
-	prefixes := convertInterfaceToString(d.Get("prefixes").([]interface{}))
-	suffixes := convertInterfaceToString(d.Get("suffixes").([]interface{}))
-	separator := d.Get("separator").(string)
+// getNameResult sets the names and the other computed attributes of azurecaf_name. It
+// returns the warnings of the uniqueness policy along with the error.
+func getNameResult(d *schema.ResourceData, meta interface{}) (diag.Diagnostics, error) {
+	inputs, err := readNameInputs(d, meta)
+	if err != nil {
+		return nil, err
+	}
 	resourceType := d.Get("resource_type").(string)
 	resourceTypes := convertInterfaceToString(d.Get("resource_types").([]interface{}))
-	cleanInput := d.Get("clean_input").(bool)
-	passthrough := d.Get("passthrough").(bool)
-	useSlug := d.Get("use_slug").(bool)
-	randomLength := d.Get("random_length").(int)
-	randomSeed := int64(d.Get("random_seed").(int))
-	errorWhenExceedingMaxLength := d.Get("error_when_exceeding_max_length").(bool)
+	randomLength := inputs.RandomLength
+	randomSeed := inputs.RandomSeed
 
 	// Validate random_length parameter
 	if randomLength < 0 {
-		return fmt.Errorf("random_length must be non-negative, got: %d", randomLength)
+		return nil, fmt.Errorf("random_length must be non-negative, got: %d", randomLength)
+	}
+
+	definitions, err := definitionsFor(meta, inputs.RulesVersion)
+	if err != nil {
+		return nil, err
 	}
 
 	// Validate against resource type constraints if resource_type is specified
 	if resourceType != "" {
-		if resource, exists := ResourceDefinitions[resourceType]; exists {
+		if resource, err := definitions.getResource(resourceType); err == nil {
 			maxLen := resource.MaxLength
 			if randomLength > maxLen {
-				return fmt.Errorf("random_length (%d) exceeds maximum length for resource type %s (%d)", randomLength, resourceType, maxLen)
+				return nil, fmt.Errorf("random_length (%d) exceeds maximum length for resource type %s (%d)", randomLength, resourceType, maxLen)
+			}
+			if inputs.HashLength > maxLen {
+				return nil, fmt.Errorf("hash_length (%d) exceeds maximum length for resource type %s (%d)", inputs.HashLength, resourceType, maxLen)
 			}
 		}
 	}
 
 	convention := ConventionCafClassic
 
-	randomSuffix := randSeq(int(randomLength), &randomSeed)
-	namePrecedence := []string{"name", "slug", "random", "suffixes", "prefixes"}
+	random := randomValues(randomLength, &randomSeed)
 
-	isValid, err := validateResourceType(resourceType, resourceTypes)
+	isValid, err := definitions.validateResourceType(resourceType, resourceTypes)
 	if !isValid {
-		return err
+		return nil, err
+	}
+	inputs.AdaptSeparator = d.Get("adapt_separator").(bool)
+	inputs.Separators, err = definitions.resolveSeparators(convertInterfaceMapToString(d.Get("separators").(map[string]interface{})), append([]string{resourceType}, resourceTypes...))
+	if err != nil {
+		return nil, err
 	}
 
+	resources := []*ResourceStructure{}
+	for _, resourceTypeName := range append([]string{resourceType}, resourceTypes...) {
+		if resource, err := definitions.getResource(resourceTypeName); err == nil {
+			resources = append(resources, resource)
+		}
+	}
+	collisionProbability, diags := checkUniqueness(resources, inputs)
+	if diags.HasError() {
+		return nil, diagnosticsError(diags)
+	}
+
+	cleaned := []interface{}{}
+	repairs := []string{}
+	composition := []interface{}{}
 	if len(resourceType) > 0 {
-		resourceName, err := getResourceName(resourceType, separator, prefixes, name, suffixes, randomSuffix, convention, cleanInput, passthrough, useSlug, namePrecedence, errorWhenExceedingMaxLength)
+		resource, _ := definitions.getResource(resourceType)
+		cleaned = append(cleaned, flattenCleanedCharacters(resourceType, cleanedCharacters(inputs, resource)))
+		randomSuffix, err := randomSuffixFor(resource, inputs.RandomCharacterSet, random)
+		if err != nil {
+			return diags, err
+		}
+		generated, err := generateResourceName(resource, inputs, randomSuffix, convention)
 		if err != nil {
-			return err
+			return diags, err
 		}
-		d.Set("result", resourceName)
+		d.Set("result", generated.Name)
+		repairs = append(repairs, generated.Repairs...)
+		composition = append(composition, flattenNameComposition(resourceType, generated.Composition))
 	}
 	resourceNames := make(map[string]string, len(resourceTypes))
 	for _, resourceTypeName := range resourceTypes {
-		var err error
-		resourceNames[resourceTypeName], err = getResourceName(resourceTypeName, separator, prefixes, name, suffixes, randomSuffix, convention, cleanInput, passthrough, useSlug, namePrecedence, errorWhenExceedingMaxLength)
+		resource, _ := definitions.getResource(resourceTypeName)
+		cleaned = append(cleaned, flattenCleanedCharacters(resourceTypeName, cleanedCharacters(inputs, resource)))
+		randomSuffix, err := randomSuffixFor(resource, inputs.RandomCharacterSet, random)
 		if err != nil {
-			return err
+			return diags, err
 		}
+		generated, err := generateResourceName(resource, inputs, randomSuffix, convention)
+		if err != nil {
+			return diags, err
+		}
+		resourceNames[resourceTypeName] = generated.Name
+		repairs = append(repairs, generated.Repairs...)
+		composition = append(composition, flattenNameComposition(resourceTypeName, generated.Composition))
 	}
 	d.Set("results", resourceNames)
+	d.Set("cleaned_characters", cleaned)
+	d.Set("repairs", repairs)
+	d.Set("composition", composition)
+	d.Set("slug_is_official", len(definitions.outOfDocSlugs(append(resourceTypes, resourceType), inputs.UseSlug)) == 0)
+	d.Set("collision_probability", collisionProbability)
 	d.SetId(randSeq(16, nil))
-	return nil
+	return diags, nil
 }
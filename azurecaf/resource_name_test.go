@@ -7,6 +7,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -122,7 +124,7 @@ func TestAccResourceName_CafClassic(t *testing.T) {
 			"clean_input":   true,
 		})
 
-		err := nameResource.Create(resourceData, nil)
+		err := diagnosticsError(nameResource.CreateContext(context.Background(), resourceData, nil))
 		if err != nil {
 			t.Fatalf("Failed to create resource: %v", err)
 		}
@@ -149,7 +151,7 @@ func TestAccResourceName_CafClassic(t *testing.T) {
 			"clean_input":   true,
 		})
 
-		err := nameResource.Create(resourceData, nil)
+		err := diagnosticsError(nameResource.CreateContext(context.Background(), resourceData, nil))
 		if err != nil {
 			t.Fatalf("Failed to create resource: %v", err)
 		}
@@ -179,7 +181,7 @@ func TestAccResourceName_CafClassic(t *testing.T) {
 			"passthrough":   true,
 		})
 
-		err := nameResource.Create(resourceData, nil)
+		err := diagnosticsError(nameResource.CreateContext(context.Background(), resourceData, nil))
 		if err != nil {
 			t.Fatalf("Failed to create resource: %v", err)
 		}
@@ -201,7 +203,7 @@ func TestAccResourceName_CafClassic(t *testing.T) {
 			"clean_input":   true,
 		})
 
-		err := nameResource.Create(resourceData, nil)
+		err := diagnosticsError(nameResource.CreateContext(context.Background(), resourceData, nil))
 		if err != nil {
 			t.Fatalf("Failed to create resource: %v", err)
 		}
@@ -230,7 +232,7 @@ func TestAccResourceName_CafClassic(t *testing.T) {
 			"clean_input":   true,
 		})
 
-		err := nameResource.Create(resourceData, nil)
+		err := diagnosticsError(nameResource.CreateContext(context.Background(), resourceData, nil))
 		if err != nil {
 			t.Fatalf("Failed to create resource: %v", err)
 		}
@@ -270,7 +272,7 @@ func TestAccResourceName_CafClassicRSV(t *testing.T) {
 			"passthrough":   false,
 		})
 
-		err := nameResource.Create(resourceData, nil)
+		err := diagnosticsError(nameResource.CreateContext(context.Background(), resourceData, nil))
 		if err != nil {
 			t.Fatalf("Failed to create resource: %v", err)
 		}
@@ -380,7 +382,7 @@ func TestResourceName_ErrorWhenExceedingMaxLength(t *testing.T) {
 		"error_when_exceeding_max_length": true,
 	})
 
-	err := nameResource.Create(resourceData, nil)
+	err := diagnosticsError(nameResource.CreateContext(context.Background(), resourceData, nil))
 	if err == nil {
 		t.Errorf("expected error when name exceeds max length, got nil")
 	}
@@ -588,3 +590,33 @@ resource "azurecaf_name" "rsv" {
 	passthrough     = false
 }
 `
+
+func TestResourceNameValidateSlugs(t *testing.T) {
+	validate := func(values map[string]cty.Value) diag.Diagnostics {
+		config := map[string]cty.Value{
+			"resource_type":  cty.NullVal(cty.String),
+			"resource_types": cty.NullVal(cty.List(cty.String)),
+			"use_slug":       cty.NullVal(cty.Bool),
+		}
+		for k, v := range values {
+			config[k] = v
+		}
+		resp := &schema.ValidateResourceConfigFuncResponse{}
+		resourceNameValidateSlugs(context.Background(), schema.ValidateResourceConfigFuncRequest{RawConfig: cty.ObjectVal(config)}, resp)
+		return resp.Diagnostics
+	}
+
+	diags := validate(map[string]cty.Value{
+		"resource_type":  cty.StringVal("azurerm_resource_group"),
+		"resource_types": cty.ListVal([]cty.Value{cty.StringVal("azurerm_private_dns_a_record"), cty.UnknownVal(cty.String)}),
+	})
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Errorf("expected a warning for the out-of-doc slug, got %v", diags)
+	}
+	if diags := validate(map[string]cty.Value{
+		"resource_type": cty.StringVal("azurerm_private_dns_a_record"),
+		"use_slug":      cty.False,
+	}); len(diags) != 0 {
+		t.Errorf("expected no warning without slug, got %v", diags)
+	}
+}
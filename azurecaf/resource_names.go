@@ -0,0 +1,538 @@
+package azurecaf
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// namesSharedAttributes are the attributes of azurecaf_names applied to every entry.
+var namesSharedAttributes = []string{
+	"prefixes", "suffixes", "separator", "random_length", "random_seed", "random_character_set",
+	"clean_input", "transliterate", "use_slug", "shortening", "padding", "case", "format", "format_values", "profile",
+	"error_when_exceeding_max_length", "uniqueness_policy", "rules_version",
+}
+
+// resourceNames creates and returns the schema for the azurecaf_names resource.
+//
+// This resource generates many names sharing the same prefixes, suffixes and options
+// in a single block, each entry having its own base name and resource type:
+//   - Every entry is keyed by a logical name, e.g. "rg" or "storage", and may override
+//     the prefixes, suffixes, separator, random_length, format and format_values
+//   - The names are returned in the results map, keyed by the same logical names
+//   - All the failing entries are reported in a single diagnostic
+//   - Adding, removing or changing entries leaves the names of the other entries,
+//     random characters included, untouched
+func resourceNames() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNamesCreate,
+		ReadContext:   schema.NoopContext,
+		UpdateContext: resourceNamesUpdate,
+		DeleteContext: resourceNamesDelete,
+		CustomizeDiff: resourceNamesCustomizeDiff,
+		Schema:        namesSchema(),
+	}
+}
+
+// namesSchema returns the schema shared by the azurecaf_names resource and data source.
+func namesSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"entry": {
+			Type:     schema.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"key": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "Logical key of the name in the results map, e.g. \"rg\".",
+					},
+					"name": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Default:     "",
+						Description: "Base name of the resource.",
+					},
+					"resource_type": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "Azure resource type of the name, e.g. \"azurerm_resource_group\", its CAF slug or its ARM resource type.",
+					},
+					"prefixes": {
+						Type: schema.TypeList,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						Optional:    true,
+						Description: "Prefixes of the entry, replacing the shared prefixes when set.",
+					},
+					"suffixes": {
+						Type: schema.TypeList,
+						Elem: &schema.Schema{
+							Type:         schema.TypeString,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						Optional:    true,
+						Description: "Suffixes of the entry, replacing the shared suffixes when set.",
+					},
+					"separator": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "Separator of the entry, replacing the shared separator when not empty.",
+					},
+					"random_length": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+						Description:  "Number of random characters of the entry, replacing the shared random_length when not 0.",
+					},
+					"format": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validateNameFormat,
+						Description:  "Format of the entry, replacing the shared format when set.",
+					},
+					"format_values": {
+						Type:        schema.TypeMap,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Optional:    true,
+						Description: "Values of the custom placeholders of the entry, merged over the shared format_values.",
+					},
+				},
+			},
+			Description: "Names to generate, one block per logical key.",
+		},
+		"prefixes": {
+			Type: schema.TypeList,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Optional:    true,
+			Description: "List of prefixes shared by the entries.",
+		},
+		"suffixes": {
+			Type: schema.TypeList,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Optional:    true,
+			Description: "List of suffixes shared by the entries.",
+		},
+		"separator": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Default:     "-",
+			Description: "Separator shared by the entries.",
+		},
+		"random_length": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+			Default:      0,
+			Description:  "Number of random characters appended to every name.",
+		},
+		"random_seed": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Description: "Seed of the random characters, every entry of the same random_length then gets the same random characters.",
+		},
+		"random_character_set": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(randomCharacterSets, false),
+			Description:  "Characters of the random segment: lowercase (default), letters, alphanumeric or numeric.",
+		},
+		"clean_input": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Remove the characters that are not allowed by the naming rules of the resource types.",
+		},
+		"transliterate": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Replace the accented and other non-ASCII letters of the inputs with their ASCII spelling before cleaning them, see the transliterate attribute of azurecaf_name.",
+		},
+		"use_slug": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     true,
+			Description: "Include the CAF slug of the resource types in the names.",
+		},
+		"shortening": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(shorteningModes, false),
+			Description:  "Strategy applied when a name exceeds the maximum length: truncate (default), proportional, vowels or hash.",
+		},
+		"padding": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(paddingModes, false),
+			Description:  "Characters appended to the names shorter than the minimum length of their resource type and refused by its validation regex: hash (default), random or none.",
+		},
+		"case": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(nameCases, false),
+			Description:  "Case of the names: preserve (default), lower, upper, pascal or camel, see the case attribute of azurecaf_name.",
+		},
+		"format": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateNameFormat,
+			Description:  "Format shared by the entries, see the format attribute of azurecaf_name.",
+		},
+		"format_values": {
+			Type:        schema.TypeMap,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+			Description: "Values of the custom placeholders shared by the entries.",
+		},
+		"profile": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Name of a profile defined in the provider configuration whose defaults are applied to the entries.",
+		},
+		"error_when_exceeding_max_length": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "When true, returns an error for the names exceeding the maximum length of their resource type instead of truncating them.",
+		},
+		"uniqueness_policy": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(uniquenessPolicies, false),
+			Description:  "Policy applied to the names of the resource types of global scope whose random and hash segments carry too little entropy: off (default), warn or fail.",
+		},
+		"rules_version": rulesVersionSchema(false, "Version of the built-in naming rules used to generate the names, e.g. v1, or latest. Overrides the rules_version of the provider."),
+		"results": {
+			Type:        schema.TypeMap,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Computed:    true,
+			Description: "Generated names, keyed by the entry keys.",
+		},
+	}
+}
+
+// namesState is the part of schema.ResourceData and schema.ResourceDiff used to
+// find the entries whose name can be kept.
+type namesState interface {
+	attributeReader
+	Id() string
+	HasChanges(keys ...string) bool
+	GetChange(key string) (interface{}, interface{})
+}
+
+// nameEntry is an entry block of azurecaf_names.
+type nameEntry struct {
+	Key          string
+	Name         string
+	ResourceType string
+	Prefixes     []string
+	Suffixes     []string
+	Separator    string
+	RandomLength int
+	Format       string
+	FormatValues map[string]string
+}
+
+func expandNameEntries(set *schema.Set) []nameEntry {
+	entries := make([]nameEntry, 0, set.Len())
+	for _, item := range set.List() {
+		values := item.(map[string]interface{})
+		entries = append(entries, nameEntry{
+			Key:          values["key"].(string),
+			Name:         values["name"].(string),
+			ResourceType: values["resource_type"].(string),
+			Prefixes:     convertInterfaceToString(values["prefixes"].([]interface{})),
+			Suffixes:     convertInterfaceToString(values["suffixes"].([]interface{})),
+			Separator:    values["separator"].(string),
+			RandomLength: values["random_length"].(int),
+			Format:       values["format"].(string),
+			FormatValues: convertInterfaceMapToString(values["format_values"].(map[string]interface{})),
+		})
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Key < entries[j].Key })
+	return entries
+}
+
+// readSharedNameInputs reads the attributes of azurecaf_names shared by the entries
+// and completes them with the provider defaults and the selected profile.
+func readSharedNameInputs(d attributeReader, meta interface{}) (nameInputs, error) {
+	inputs := nameInputs{
+		Prefixes:                    convertInterfaceToString(d.Get("prefixes").([]interface{})),
+		Suffixes:                    convertInterfaceToString(d.Get("suffixes").([]interface{})),
+		Separator:                   d.Get("separator").(string),
+		RandomLength:                d.Get("random_length").(int),
+		RandomSeed:                  int64(d.Get("random_seed").(int)),
+		RandomCharacterSet:          d.Get("random_character_set").(string),
+		CleanInput:                  d.Get("clean_input").(bool),
+		Transliterate:               d.Get("transliterate").(bool),
+		UseSlug:                     d.Get("use_slug").(bool),
+		ErrorWhenExceedingMaxLength: d.Get("error_when_exceeding_max_length").(bool),
+		Shortening:                  d.Get("shortening").(string),
+		Padding:                     d.Get("padding").(string),
+		Case:                        d.Get("case").(string),
+		FormatValues:                convertInterfaceMapToString(d.Get("format_values").(map[string]interface{})),
+		UniquenessPolicy:            d.Get("uniqueness_policy").(string),
+		RulesVersion:                d.Get("rules_version").(string),
+	}
+	if template := d.Get("format").(string); template != "" {
+		format, err := parseNameFormat(template)
+		if err != nil {
+			return inputs, err
+		}
+		inputs.Format = format
+	}
+	if err := validateFormatValues(inputs.FormatValues); err != nil {
+		return inputs, err
+	}
+	err := applyNamingDefaults(d, meta, &inputs)
+	return inputs, err
+}
+
+// inputs returns the shared inputs overridden by the values set on the entry.
+func (e nameEntry) inputs(shared nameInputs) (nameInputs, error) {
+	inputs := shared
+	inputs.Name = e.Name
+	if len(e.Prefixes) > 0 {
+		inputs.Prefixes = e.Prefixes
+	}
+	if len(e.Suffixes) > 0 {
+		inputs.Suffixes = e.Suffixes
+	}
+	if e.Separator != "" {
+		inputs.Separator = e.Separator
+	}
+	if e.RandomLength > 0 {
+		inputs.RandomLength = e.RandomLength
+	}
+	if e.Format != "" {
+		format, err := parseNameFormat(e.Format)
+		if err != nil {
+			return inputs, err
+		}
+		inputs.Format = format
+	}
+	if len(e.FormatValues) > 0 {
+		inputs.FormatValues = make(map[string]string, len(shared.FormatValues)+len(e.FormatValues))
+		for k, v := range shared.FormatValues {
+			inputs.FormatValues[k] = v
+		}
+		for k, v := range e.FormatValues {
+			inputs.FormatValues[k] = v
+		}
+		if err := validateFormatValues(inputs.FormatValues); err != nil {
+			return inputs, err
+		}
+	}
+	return inputs, nil
+}
+
+// generateNames generates the name of every entry of d. The names of the entries
+// listed in keep are reused as is. Every failing entry is reported in a single
+// error diagnostic, the warnings of the uniqueness policy are returned along with
+// the names.
+func generateNames(d attributeReader, meta interface{}, keep map[string]string) (map[string]string, diag.Diagnostics) {
+	shared, err := readSharedNameInputs(d, meta)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	entries := expandNameEntries(d.Get("entry").(*schema.Set))
+	definitions, err := definitionsFor(meta, shared.RulesVersion)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	results := make(map[string]string, len(entries))
+	var warnings diag.Diagnostics
+	failures := []string{}
+	seen := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		if seen[entry.Key] {
+			failures = append(failures, fmt.Sprintf("%s: key is used by more than one entry", entry.Key))
+			continue
+		}
+		seen[entry.Key] = true
+		if name, ok := keep[entry.Key]; ok {
+			results[entry.Key] = name
+			continue
+		}
+		name, diags := generateEntryName(entry, shared, definitions)
+		if diags.HasError() {
+			failures = append(failures, fmt.Sprintf("%s: %s", entry.Key, diagnosticsError(diags)))
+			continue
+		}
+		for _, warning := range diags {
+			warning.Summary = fmt.Sprintf("%s: %s", entry.Key, warning.Summary)
+			warnings = append(warnings, warning)
+		}
+		results[entry.Key] = name
+	}
+
+	if len(failures) > 0 {
+		return nil, diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  fmt.Sprintf("%d of %d names could not be generated", len(failures), len(entries)),
+			Detail:   strings.Join(failures, "\n"),
+		}}
+	}
+	return results, warnings
+}
+
+// generateEntryName generates the name of an entry. The diagnostics hold either a
+// single error or the warnings of the uniqueness policy.
+func generateEntryName(entry nameEntry, shared nameInputs, definitions resourceDefinitionSet) (string, diag.Diagnostics) {
+	inputs, err := entry.inputs(shared)
+	if err != nil {
+		return "", diag.FromErr(err)
+	}
+	resource, err := definitions.getResource(entry.ResourceType)
+	if err != nil {
+		return "", diag.FromErr(err)
+	}
+	if inputs.RandomLength > resource.MaxLength {
+		return "", diag.Errorf("random_length (%d) exceeds maximum length for resource type %s (%d)", inputs.RandomLength, entry.ResourceType, resource.MaxLength)
+	}
+	_, diags := checkUniqueness([]*ResourceStructure{resource}, inputs)
+	if diags.HasError() {
+		return "", diags
+	}
+	randomSeed := inputs.RandomSeed
+	randomSuffix, err := randomSuffixFor(resource, inputs.RandomCharacterSet, randomValues(inputs.RandomLength, &randomSeed))
+	if err != nil {
+		return "", diag.FromErr(err)
+	}
+	name, err := getResourceNameForDefinition(resource, inputs, randomSuffix, ConventionCafClassic)
+	if err != nil {
+		return "", diag.FromErr(err)
+	}
+	return name, diags
+}
+
+// unchangedNames returns the current names of the entries whose configuration did
+// not change. Nothing is kept when a shared attribute changed.
+func unchangedNames(d namesState) map[string]string {
+	if d.Id() == "" || d.HasChanges(namesSharedAttributes...) {
+		return nil
+	}
+	oldResults, _ := d.GetChange("results")
+	current, _ := oldResults.(map[string]interface{})
+	oldEntries, newEntries := d.GetChange("entry")
+	oldSet, ok := oldEntries.(*schema.Set)
+	if !ok {
+		return nil
+	}
+	newSet, ok := newEntries.(*schema.Set)
+	if !ok {
+		return nil
+	}
+
+	previous := map[string]nameEntry{}
+	for _, entry := range expandNameEntries(oldSet) {
+		previous[entry.Key] = entry
+	}
+	keep := map[string]string{}
+	for _, entry := range expandNameEntries(newSet) {
+		name, exists := current[entry.Key].(string)
+		if exists && reflect.DeepEqual(previous[entry.Key], entry) {
+			keep[entry.Key] = name
+		}
+	}
+	return keep
+}
+
+// drawsUnseededRandom reports whether a name generated from inputs holds random
+// characters which do not derive from random_seed, they differ every time the name
+// is generated.
+func drawsUnseededRandom(inputs nameInputs) bool {
+	return inputs.RandomSeed == 0 && (inputs.RandomLength > 0 || inputs.Padding == PaddingRandom)
+}
+
+// plansUnseededRandom reports whether one of the names to generate, the ones of the
+// entries missing from keep, draws unseeded random characters. Terraform plans again
+// at apply time, such names would differ between the two plans.
+func plansUnseededRandom(d attributeReader, meta interface{}, keep map[string]string) bool {
+	shared, err := readSharedNameInputs(d, meta)
+	if err != nil {
+		return false
+	}
+	for _, entry := range expandNameEntries(d.Get("entry").(*schema.Set)) {
+		if _, ok := keep[entry.Key]; ok {
+			continue
+		}
+		if inputs, err := entry.inputs(shared); err == nil && drawsUnseededRandom(inputs) {
+			return true
+		}
+	}
+	return false
+}
+
+// resourceNamesCustomizeDiff plans the names when the configuration is known, so
+// that the entries which did not change keep their name in the plan. Otherwise, or
+// when a name draws random characters without random_seed, the names are generated
+// at apply time.
+func resourceNamesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if rawConfig := d.GetRawConfig(); rawConfig.IsNull() || !rawConfig.IsWhollyKnown() {
+		return d.SetNewComputed("results")
+	}
+	keep := unchangedNames(d)
+	if plansUnseededRandom(d, meta, keep) {
+		return d.SetNewComputed("results")
+	}
+	results, diags := generateNames(d, meta, keep)
+	if diags.HasError() {
+		return fmt.Errorf("%s:\n%s", diags[0].Summary, diags[0].Detail)
+	}
+	return d.SetNew("results", results)
+}
+
+func resourceNamesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	diags := setNamesResults(d, meta)
+	if diags.HasError() {
+		return diags
+	}
+	d.SetId(randSeq(16, nil))
+	return diags
+}
+
+func resourceNamesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return setNamesResults(d, meta)
+}
+
+func resourceNamesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// setNamesResults stores the planned names, or generates them when they were not
+// known at plan time. The plan cannot return warnings, the names are generated again
+// at apply time to return the warnings of the uniqueness policy.
+func setNamesResults(d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	results, diags := generateNames(d, meta, unchangedNames(d))
+	if diags.HasError() {
+		return diags
+	}
+	planned := d.Get("results").(map[string]interface{})
+	if len(planned) == d.Get("entry").(*schema.Set).Len() {
+		return diags
+	}
+	if err := d.Set("results", results); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	return diags
+}
@@ -0,0 +1,138 @@
+package azurecaf
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"testing"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestDataNamesRead(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataNames().Schema, map[string]interface{}{
+		"prefixes": []interface{}{"contoso"},
+		"suffixes": []interface{}{"prd"},
+		"entry": []interface{}{
+			map[string]interface{}{"key": "rg", "name": "billing", "resource_type": "azurerm_resource_group"},
+			map[string]interface{}{"key": "storage", "name": "billing", "resource_type": "st"},
+			map[string]interface{}{"key": "network", "name": "network", "resource_type": "azurerm_resource_group", "prefixes": []interface{}{"cts"}, "separator": "_"},
+		},
+	})
+	if diags := dataNamesRead(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	results := d.Get("results").(map[string]interface{})
+	expected := map[string]string{
+		"rg":      "contoso-rg-billing-prd",
+		"storage": "contosostbillingprd",
+		"network": "cts_rg_network_prd",
+	}
+	for key, name := range expected {
+		if results[key] != name {
+			t.Errorf("expected %s for %s, got %v", name, key, results[key])
+		}
+	}
+}
+
+func TestDataNamesRead_ReportsEveryFailure(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, dataNames().Schema, map[string]interface{}{
+		"entry": []interface{}{
+			map[string]interface{}{"key": "rg", "name": "billing", "resource_type": "azurerm_resource_group"},
+			map[string]interface{}{"key": "unknown", "name": "billing", "resource_type": "azurerm_does_not_exist"},
+			map[string]interface{}{"key": "format", "name": "billing", "resource_type": "azurerm_resource_group", "format": "{env}{name}"},
+		},
+	})
+	diags := dataNamesRead(context.Background(), d, nil)
+	if len(diags) != 1 || !diags.HasError() {
+		t.Fatalf("expected a single error diagnostic, got %v", diags)
+	}
+	if !strings.Contains(diags[0].Summary, "2 of 3") {
+		t.Errorf("expected the summary to count the failures, got %s", diags[0].Summary)
+	}
+	for _, key := range []string{"unknown:", "format:"} {
+		if !strings.Contains(diags[0].Detail, key) {
+			t.Errorf("expected the detail to report %s, got %s", key, diags[0].Detail)
+		}
+	}
+}
+
+func TestResourceNames_KeepsUnchangedEntries(t *testing.T) {
+	ctx := context.Background()
+	r := resourceNames()
+	entries := []interface{}{
+		map[string]interface{}{"key": "rg", "name": "billing", "resource_type": "azurerm_resource_group"},
+		map[string]interface{}{"key": "storage", "name": "billing", "resource_type": "azurerm_storage_account"},
+	}
+	apply := func(state *terraform.InstanceState, raw map[string]interface{}) *terraform.InstanceState {
+		t.Helper()
+		diff, err := r.Diff(ctx, state, terraform.NewResourceConfigRaw(raw), nil)
+		if err != nil {
+			t.Fatalf("unexpected diff error: %v", err)
+		}
+		newState, diags := r.Apply(ctx, state, diff, nil)
+		if diags.HasError() {
+			t.Fatalf("unexpected apply error: %v", diags)
+		}
+		return newState
+	}
+
+	state := apply(nil, map[string]interface{}{"random_length": 5, "entry": entries})
+	rg, storage := state.Attributes["results.rg"], state.Attributes["results.storage"]
+	if !regexp.MustCompile(`^rg-billing-[a-z]{5}$`).MatchString(rg) {
+		t.Fatalf("unexpected resource group name %q", rg)
+	}
+
+	entries[1] = map[string]interface{}{"key": "storage", "name": "payments", "resource_type": "azurerm_storage_account"}
+	entries = append(entries, map[string]interface{}{"key": "kv", "name": "billing", "resource_type": "azurerm_key_vault"})
+	state = apply(state, map[string]interface{}{"random_length": 5, "entry": entries})
+
+	if state.Attributes["results.rg"] != rg {
+		t.Errorf("expected the unchanged entry to keep %s, got %s", rg, state.Attributes["results.rg"])
+	}
+	if updated := state.Attributes["results.storage"]; updated == storage || !strings.HasPrefix(updated, "stpayments") {
+		t.Errorf("expected the changed entry to be regenerated, got %s", updated)
+	}
+	if state.Attributes["results.kv"] == "" {
+		t.Error("expected the added entry to be generated")
+	}
+}
+
+func TestResourceNames_PlansConsistently(t *testing.T) {
+	ctx := context.Background()
+	r := resourceNames()
+	entries := []interface{}{
+		map[string]interface{}{"key": "rg", "name": "billing", "resource_type": "azurerm_resource_group"},
+	}
+	// plan diffs the configuration with its raw value, as Terraform does
+	plan := func(raw map[string]interface{}) *terraform.InstanceDiff {
+		t.Helper()
+		encoded, _ := json.Marshal(raw)
+		rawConfig, err := ctyjson.Unmarshal(encoded, r.CoreConfigSchema().ImpliedType())
+		if err != nil {
+			t.Fatalf("unexpected config error: %v", err)
+		}
+		diff, err := r.Diff(ctx, &terraform.InstanceState{RawConfig: rawConfig}, terraform.NewResourceConfigRaw(raw), nil)
+		if err != nil {
+			t.Fatalf("unexpected diff error: %v", err)
+		}
+		return diff
+	}
+
+	// Unseeded random characters would differ between the plan and the plan of the apply
+	diff := plan(map[string]interface{}{"random_length": 5, "entry": entries})
+	if results := diff.Attributes["results.%"]; results == nil || !results.NewComputed {
+		t.Errorf("expected the results to be unknown until apply, got %v", results)
+	}
+
+	// Seeded names are planned, the same way every time
+	raw := map[string]interface{}{"random_length": 5, "random_seed": 42, "entry": entries}
+	first, second := plan(raw).Attributes["results.rg"], plan(raw).Attributes["results.rg"]
+	if first == nil || second == nil || first.NewComputed || first.New != second.New {
+		t.Errorf("expected the same planned name twice, got %v and %v", first, second)
+	}
+}
@@ -3,7 +3,6 @@ package azurecaf
 import (
 	"context"
 	"fmt"
-	"math/rand"
 	"regexp"
 	"strings"
 
@@ -228,7 +227,7 @@ func getResult(d *schema.ResourceData, meta interface{}) error {
 	result := string(filteredGeneratedName[0:length])
 	// making sure the last char is alpha char if we included random string
 	if containsRandomChar && len(result) > len(userInputName) {
-		randomLastChar := alphagenerator[rand.Intn(len(alphagenerator)-1)]
+		randomLastChar := []rune(randSeq(1, nil))[0]
 		resultRune := []rune(result)
 		resultRune[len(resultRune)-1] = randomLastChar
 		result = string(resultRune)
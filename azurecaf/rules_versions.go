@@ -0,0 +1,160 @@
+package azurecaf
+
+import (
+	"embed"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// RulesVersionLatest selects the definitions compiled in from resourceDefinition.json.
+const RulesVersionLatest = "latest"
+
+// rulesSnapshots holds the frozen copies of resourceDefinition.json, one per rules
+// version, e.g. rules/v1.json. A snapshot is never modified once released: a change
+// of the naming rules of an existing resource type adds a new snapshot, so that the
+// configurations pinning an older version keep generating the same names.
+//
+//go:embed rules/*.json
+var rulesSnapshots embed.FS
+
+var (
+	rulesSnapshotsMutex sync.Mutex
+	rulesSnapshotSets   = map[string]resourceDefinitionSet{}
+)
+
+// rulesVersions returns the versions of the embedded snapshots, oldest first.
+func rulesVersions() []string {
+	entries, err := rulesSnapshots.ReadDir("rules")
+	if err != nil {
+		return nil
+	}
+	versions := []string{}
+	for _, entry := range entries {
+		versions = append(versions, strings.TrimSuffix(entry.Name(), path.Ext(entry.Name())))
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return rulesVersionNumber(versions[i]) < rulesVersionNumber(versions[j])
+	})
+	return versions
+}
+
+// rulesVersionNumber returns the number of a version, e.g. 2 for v2.
+func rulesVersionNumber(version string) int {
+	number, err := strconv.Atoi(strings.TrimPrefix(version, "v"))
+	if err != nil {
+		return 0
+	}
+	return number
+}
+
+// availableRulesVersions returns the values accepted by the rules_version attributes.
+func availableRulesVersions() []string {
+	return append(rulesVersions(), RulesVersionLatest)
+}
+
+// rulesVersionSchema returns the schema of a rules_version attribute.
+func rulesVersionSchema(forceNew bool, description string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     forceNew,
+		ValidateFunc: validation.StringInSlice(availableRulesVersions(), false),
+		Description:  description,
+	}
+}
+
+// rulesDefinitionSet returns the built-in definitions of a rules version. An empty
+// version selects the latest definitions.
+func rulesDefinitionSet(version string) (resourceDefinitionSet, error) {
+	if version == "" || version == RulesVersionLatest {
+		return builtinDefinitionSet, nil
+	}
+
+	rulesSnapshotsMutex.Lock()
+	defer rulesSnapshotsMutex.Unlock()
+	if set, loaded := rulesSnapshotSets[version]; loaded {
+		return set, nil
+	}
+	content, err := rulesSnapshots.ReadFile(path.Join("rules", version+".json"))
+	if err != nil {
+		return resourceDefinitionSet{}, fmt.Errorf("rules version %q is not available, available versions: %s", version, strings.Join(availableRulesVersions(), ", "))
+	}
+	definitions, err := parseResourceDefinitions(content)
+	if err != nil {
+		return resourceDefinitionSet{}, fmt.Errorf("parsing the snapshot of rules version %s: %w", version, err)
+	}
+	set := definitionSetOf(definitions)
+	rulesSnapshotSets[version] = set
+	return set, nil
+}
+
+// definitionsFor returns the definitions of the configured provider for a rules
+// version. An empty version, or the version the provider pins, selects the
+// definitions of the provider. Another version selects its snapshot, extended with
+// the custom definitions of the provider.
+func definitionsFor(meta interface{}, version string) (resourceDefinitionSet, error) {
+	config, _ := meta.(*providerConfig)
+	pinned := RulesVersionLatest
+	if config != nil && config.RulesVersion != "" {
+		pinned = config.RulesVersion
+	}
+	if version == "" || version == pinned {
+		return definitionsFromMeta(meta), nil
+	}
+	set, err := rulesDefinitionSet(version)
+	if err != nil {
+		return resourceDefinitionSet{}, err
+	}
+	if config == nil || len(config.CustomDefinitions) == 0 {
+		return set, nil
+	}
+	// The custom definitions were validated when the provider was configured, the
+	// warnings about their overrides are not repeated
+	extended, diags := set.extend(config.CustomDefinitions)
+	if diags.HasError() {
+		return resourceDefinitionSet{}, diagnosticsError(diags)
+	}
+	return *extended, nil
+}
+
+// rulesChange describes how the naming rules of a resource type differ between two
+// rules versions.
+type rulesChange struct {
+	ResourceType string
+	// Change is added, removed or changed
+	Change string
+	// Details lists the changed attributes, e.g. "max_length: 24 -> 20"
+	Details []string
+}
+
+// diffRules lists the resource types added, removed or changed from one set of
+// definitions to another, sorted by resource type.
+func diffRules(from resourceDefinitionSet, to resourceDefinitionSet) []rulesChange {
+	changes := []rulesChange{}
+	for resourceType, before := range from.Definitions {
+		after, exists := to.Definitions[resourceType]
+		if !exists {
+			changes = append(changes, rulesChange{ResourceType: resourceType, Change: "removed", Details: []string{}})
+			continue
+		}
+		if details := resourceDefinitionChanges(before, after); len(details) > 0 {
+			changes = append(changes, rulesChange{ResourceType: resourceType, Change: "changed", Details: details})
+		}
+	}
+	for resourceType := range to.Definitions {
+		if _, exists := from.Definitions[resourceType]; !exists {
+			changes = append(changes, rulesChange{ResourceType: resourceType, Change: "added", Details: []string{}})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].ResourceType < changes[j].ResourceType
+	})
+	return changes
+}
@@ -0,0 +1,207 @@
+package azurecaf
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// pinTestRulesVersion registers a rules version where the storage accounts have the
+// slug sa and are limited to 20 characters, for the duration of the test.
+func pinTestRulesVersion(t *testing.T) string {
+	t.Helper()
+	storage := ResourceDefinitions["azurerm_storage_account"]
+	storage.CafPrefix = "sa"
+	storage.MaxLength = 20
+	storage.ValidationRegExp = `^[a-z0-9]{3,20}$`
+	set := definitionSetOf([]ResourceStructure{storage, ResourceDefinitions["azurerm_resource_group"]})
+
+	rulesSnapshotsMutex.Lock()
+	rulesSnapshotSets["vtest"] = set
+	rulesSnapshotsMutex.Unlock()
+	t.Cleanup(func() {
+		rulesSnapshotsMutex.Lock()
+		delete(rulesSnapshotSets, "vtest")
+		rulesSnapshotsMutex.Unlock()
+	})
+	return "vtest"
+}
+
+func TestRulesVersions(t *testing.T) {
+	versions := availableRulesVersions()
+	if len(versions) < 2 || versions[0] != "v1" || versions[len(versions)-1] != RulesVersionLatest {
+		t.Fatalf("expected v1 first and latest last, got %v", versions)
+	}
+	for _, version := range rulesVersions() {
+		set, err := rulesDefinitionSet(version)
+		if err != nil {
+			t.Fatalf("loading %s: %v", version, err)
+		}
+		for resourceType, resource := range set.Definitions {
+			if violations := validateResourceDefinition(resource); len(violations) > 0 {
+				t.Errorf("%s: %s: %v", version, resourceType, violations)
+			}
+		}
+	}
+	if _, err := rulesDefinitionSet("v0"); err == nil || !strings.Contains(err.Error(), "available versions: v1") {
+		t.Errorf("expected the available versions in the error, got %v", err)
+	}
+}
+
+// The latest snapshot must hold the current rules of every resource type it defines,
+// otherwise the names of the configurations pinning it silently change.
+func TestRulesVersions_LatestSnapshotIsFrozen(t *testing.T) {
+	versions := rulesVersions()
+	latest := versions[len(versions)-1]
+	snapshot, err := rulesDefinitionSet(latest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, change := range diffRules(snapshot, builtinDefinitionSet) {
+		if change.Change != "added" {
+			t.Errorf("%s is %s since snapshot %s %v, copy resourceDefinition.json to azurecaf/rules/v%d.json", change.ResourceType, change.Change, latest, change.Details, rulesVersionNumber(latest)+1)
+		}
+	}
+}
+
+func TestDiffRules(t *testing.T) {
+	storage := ResourceDefinitions["azurerm_storage_account"]
+	vault := ResourceDefinitions["azurerm_key_vault"]
+	group := ResourceDefinitions["azurerm_resource_group"]
+	shorter := storage
+	shorter.MaxLength = 20
+
+	changes := diffRules(definitionSetOf([]ResourceStructure{storage, vault}), definitionSetOf([]ResourceStructure{shorter, group}))
+	expected := []rulesChange{
+		{ResourceType: "azurerm_key_vault", Change: "removed", Details: []string{}},
+		{ResourceType: "azurerm_resource_group", Change: "added", Details: []string{}},
+		{ResourceType: "azurerm_storage_account", Change: "changed", Details: []string{"max_length: 24 -> 20"}},
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("expected %v, got %v", expected, changes)
+	}
+}
+
+func TestDefinitionsFor(t *testing.T) {
+	version := pinTestRulesVersion(t)
+
+	if definitions, err := definitionsFor(nil, version); err != nil || definitions.Definitions["azurerm_storage_account"].MaxLength != 20 {
+		t.Errorf("expected the pinned snapshot, got %v", err)
+	}
+	if definitions, _ := definitionsFor(nil, RulesVersionLatest); definitions.Definitions["azurerm_storage_account"].MaxLength != 24 {
+		t.Error("expected the latest definitions")
+	}
+
+	pinned, _ := rulesDefinitionSet(version)
+	config := &providerConfig{RulesVersion: version, Definitions: &pinned}
+	if definitions, _ := definitionsFor(config, ""); definitions.Definitions["azurerm_storage_account"].MaxLength != 20 {
+		t.Error("expected the version pinned by the provider")
+	}
+	if definitions, _ := definitionsFor(config, RulesVersionLatest); definitions.Definitions["azurerm_storage_account"].MaxLength != 24 {
+		t.Error("expected the resource version to override the provider one")
+	}
+
+	custom := ResourceStructure{ResourceTypeName: "contoso_widget", CafPrefix: "wdg", MinLength: 1, MaxLength: 20, RegEx: `"[^0-9A-Za-z-]"`, ValidationRegExp: `"^[a-z0-9-]{1,20}$"`, Dashes: true, Scope: "resourceGroup"}
+	custom.RegEx, _ = unquoteDefinitionPattern(custom.RegEx)
+	custom.ValidationRegExp, _ = unquoteDefinitionPattern(custom.ValidationRegExp)
+	config = &providerConfig{CustomDefinitions: []ResourceStructure{custom}}
+	config.Definitions, _ = newResourceDefinitionSet(config.CustomDefinitions)
+	definitions, err := definitionsFor(config, version)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := definitions.getResource("wdg"); err != nil || definitions.Definitions["azurerm_storage_account"].MaxLength != 20 {
+		t.Errorf("expected the custom definitions on top of the pinned snapshot, got %v", err)
+	}
+}
+
+func TestRulesVersion_PinsNames(t *testing.T) {
+	version := pinTestRulesVersion(t)
+	raw := map[string]interface{}{
+		"name":          "contoso",
+		"resource_type": "azurerm_storage_account",
+	}
+
+	d := schema.TestResourceDataRaw(t, dataName().Schema, raw)
+	if diags := dataNameRead(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if result := d.Get("result").(string); result != "stcontoso" {
+		t.Errorf("expected the latest rules, got %s", result)
+	}
+
+	raw["rules_version"] = version
+	d = schema.TestResourceDataRaw(t, dataName().Schema, raw)
+	if diags := dataNameRead(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if result := d.Get("result").(string); result != "sacontoso" {
+		t.Errorf("expected the pinned rules, got %s", result)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceName().Schema, raw)
+	if _, err := getNameResult(d, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result := d.Get("result").(string); result != "sacontoso" {
+		t.Errorf("expected the pinned rules, got %s", result)
+	}
+
+	raw["resource_type"] = "azurerm_key_vault"
+	d = schema.TestResourceDataRaw(t, dataName().Schema, raw)
+	if diags := dataNameRead(context.Background(), d, nil); !diags.HasError() {
+		t.Error("expected the resource types missing from the pinned rules to fail")
+	}
+}
+
+func TestDataRulesDiffRead(t *testing.T) {
+	version := pinTestRulesVersion(t)
+
+	d := schema.TestResourceDataRaw(t, dataRulesDiff().Schema, map[string]interface{}{
+		"from": version,
+		"to":   "v1",
+	})
+	if diags := dataRulesDiffRead(context.Background(), d, nil); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	changed := convertInterfaceToString(d.Get("changed").([]interface{}))
+	if !reflect.DeepEqual(changed, []string{"azurerm_storage_account"}) {
+		t.Errorf("expected the storage account to change, got %v", changed)
+	}
+	if removed := d.Get("removed").([]interface{}); len(removed) != 0 {
+		t.Errorf("expected no removed type, got %v", removed)
+	}
+	if added := d.Get("added").([]interface{}); len(added) != len(ResourceDefinitions)-2 {
+		t.Errorf("expected %d added types, got %d", len(ResourceDefinitions)-2, len(added))
+	}
+	for _, change := range d.Get("changes").([]interface{}) {
+		values := change.(map[string]interface{})
+		if values["resource_type"] != "azurerm_storage_account" {
+			continue
+		}
+		if details := convertInterfaceToString(values["details"].([]interface{})); len(details) != 3 || details[0] != "slug: sa -> st" {
+			t.Errorf("expected the slug, max_length and validation_regex changes, got %v", details)
+		}
+	}
+}
+
+func TestProviderConfigure_RulesVersion(t *testing.T) {
+	config := testProviderMeta(t, map[string]interface{}{"rules_version": "v1"}).(*providerConfig)
+	if config.RulesVersion != "v1" || config.Definitions == nil || len(config.Definitions.Definitions) == 0 {
+		t.Fatalf("expected the v1 definitions, got %q", config.RulesVersion)
+	}
+
+	config = testProviderMeta(t, map[string]interface{}{
+		"rules_version":        "v1",
+		"resource_definitions": `[{"name": "contoso_widget", "slug": "wdg", "min_length": 1, "max_length": 20, "validation_regex": "\"^[a-z0-9-]{1,20}$\"", "regex": "\"[^0-9a-z-]\"", "dashes": true, "scope": "resourceGroup"}]`,
+	}).(*providerConfig)
+	if len(config.CustomDefinitions) != 1 {
+		t.Fatalf("expected the custom definition to be kept, got %v", config.CustomDefinitions)
+	}
+	if _, err := config.Definitions.getResource("wdg"); err != nil {
+		t.Errorf("expected the custom definition on top of v1, got %v", err)
+	}
+}
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aztfmod/terraform-provider-azurecaf/internal/resourcedefinition"
+)
+
+// defaultResourceList is the list of azurerm resource types scraped from the registry
+// documentation, one per line.
+const defaultResourceList = "completness/existing_tf_resources.txt"
+
+// compoundNamespaceTokens are the leading tokens of azurerm types which do not name a
+// service on their own, e.g. key in key_vault. The namespace of these types is made
+// of their first two tokens.
+var compoundNamespaceTokens = map[string]bool{
+	"api": true, "app": true, "application": true, "container": true, "data": true,
+	"dedicated": true, "dev": true, "express": true, "function": true, "key": true,
+	"log": true, "logic": true, "machine": true, "managed": true, "notification": true,
+	"private": true, "public": true, "route": true, "security": true, "service": true,
+	"shared": true, "site": true, "spring": true, "stream": true, "traffic": true,
+	"virtual": true,
+}
+
+// namespaceCoverage is the coverage of the resource types of a service namespace.
+type namespaceCoverage struct {
+	Namespace string   `json:"namespace"`
+	Covered   int      `json:"covered"`
+	Total     int      `json:"total"`
+	Coverage  float64  `json:"coverage"`
+	Missing   []string `json:"missing"`
+}
+
+// coverageReport is the coverage of a list of azurerm resource types by the definitions.
+type coverageReport struct {
+	Source     string              `json:"source"`
+	Covered    int                 `json:"covered"`
+	Total      int                 `json:"total"`
+	Coverage   float64             `json:"coverage"`
+	Namespaces []namespaceCoverage `json:"namespaces"`
+}
+
+func runCoverage(args []string) error {
+	flags := newFlagSet("coverage", "[-resources <file> | -schema <file>] [flags]")
+	resourcesPath := flags.String("resources", "", "list of azurerm resource types, one per line, defaults to "+defaultResourceList)
+	schemaPath := flags.String("schema", "", "cached output of `terraform providers schema -json`, its resources with a name argument are checked instead of -resources")
+	definitionsPath := flags.String("definitions", "resourceDefinition.json", "resource definitions to check")
+	provider := flags.String("provider", defaultSchemaProvider, "key of the azurerm provider in the schema")
+	format := flags.String("format", "markdown", "output format: markdown, json or csv")
+	out := flags.String("out", "", "file the report is written to, defaults to the standard output")
+	threshold := flags.Float64("threshold", 0, "minimum overall coverage in percent, the command fails below it")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *resourcesPath != "" && *schemaPath != "" {
+		return fmt.Errorf("-resources and -schema are mutually exclusive")
+	}
+	if err := checkFormat(*format, "markdown", "json", "csv"); err != nil {
+		return err
+	}
+
+	definitions, err := resourcedefinition.Read(*definitionsPath)
+	if err != nil {
+		return err
+	}
+	var resourceTypes []string
+	source := *schemaPath
+	if source != "" {
+		resources, err := readResourceSchemas(source, *provider)
+		if err != nil {
+			return err
+		}
+		for resourceType, resource := range resources {
+			if !resource.Block.Deprecated && resource.hasNameArgument() {
+				resourceTypes = append(resourceTypes, resourceType)
+			}
+		}
+	} else {
+		source = *resourcesPath
+		if source == "" {
+			source = defaultResourceList
+		}
+		if resourceTypes, err = readResourceList(source); err != nil {
+			return err
+		}
+	}
+
+	report := checkCoverage(definitions, resourceTypes)
+	report.Source = source
+	err = writeOutput(*out, func(w io.Writer) error {
+		switch *format {
+		case "json":
+			encoder := json.NewEncoder(w)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(report)
+		case "csv":
+			return report.writeCSV(w)
+		}
+		return report.writeMarkdown(w)
+	})
+	if err != nil {
+		return err
+	}
+	// The reported coverage is rounded, 79.96% must not pass a threshold of 80%
+	if float64(report.Covered)*100 < *threshold*float64(report.Total) {
+		return fmt.Errorf("coverage of %.1f%% is below the threshold of %.1f%%", report.Coverage, *threshold)
+	}
+	return nil
+}
+
+// readResourceList reads a list of resource types, one per line. Blank lines and
+// lines starting with # are ignored.
+func readResourceList(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var resourceTypes []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, "#") {
+			resourceTypes = append(resourceTypes, line)
+		}
+	}
+	return resourceTypes, scanner.Err()
+}
+
+// serviceNamespace returns the service namespace of an azurerm type: its first token
+// after the provider prefix and the platform tokens, e.g. storage for
+// azurerm_storage_account, or its first two tokens when the first one does not name a
+// service, e.g. key_vault for azurerm_key_vault_secret.
+func serviceNamespace(resourceType string) string {
+	tokens := strings.Split(strings.TrimPrefix(resourceType, "azurerm_"), "_")
+	for len(tokens) > 1 && platformTokens[tokens[0]] {
+		tokens = tokens[1:]
+	}
+	if len(tokens) > 1 && compoundNamespaceTokens[tokens[0]] {
+		return tokens[0] + "_" + tokens[1]
+	}
+	return tokens[0]
+}
+
+// checkCoverage computes the coverage of the resource types by the definitions,
+// overall and by service namespace. Duplicated resource types are counted once.
+func checkCoverage(definitions []resourcedefinition.ResourceStructure, resourceTypes []string) coverageReport {
+	defined := map[string]bool{}
+	for _, d := range definitions {
+		defined[d.ResourceTypeName] = true
+	}
+
+	namespaces := map[string]*namespaceCoverage{}
+	report := coverageReport{Namespaces: []namespaceCoverage{}}
+	seen := map[string]bool{}
+	sort.Strings(resourceTypes)
+	for _, resourceType := range resourceTypes {
+		if seen[resourceType] {
+			continue
+		}
+		seen[resourceType] = true
+
+		name := serviceNamespace(resourceType)
+		namespace, exists := namespaces[name]
+		if !exists {
+			namespace = &namespaceCoverage{Namespace: name, Missing: []string{}}
+			namespaces[name] = namespace
+		}
+		namespace.Total++
+		report.Total++
+		if defined[resourceType] {
+			namespace.Covered++
+			report.Covered++
+		} else {
+			namespace.Missing = append(namespace.Missing, resourceType)
+		}
+	}
+
+	for _, namespace := range namespaces {
+		namespace.Coverage = percentage(namespace.Covered, namespace.Total)
+		report.Namespaces = append(report.Namespaces, *namespace)
+	}
+	sort.Slice(report.Namespaces, func(i, j int) bool {
+		return report.Namespaces[i].Namespace < report.Namespaces[j].Namespace
+	})
+	report.Coverage = percentage(report.Covered, report.Total)
+	return report
+}
+
+// percentage returns part out of total in percent, rounded to one decimal, 100 when
+// total is 0.
+func percentage(part int, total int) float64 {
+	if total == 0 {
+		return 100
+	}
+	value, _ := strconv.ParseFloat(strconv.FormatFloat(float64(part)*100/float64(total), 'f', 1, 64), 64)
+	return value
+}
+
+// writeMarkdown writes the report as a markdown table, one row per namespace.
+func (r coverageReport) writeMarkdown(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Resource definition coverage\n\n%d of the %d resource types of %s are defined (%.1f%%).\n\n", r.Covered, r.Total, r.Source, r.Coverage)
+	b.WriteString("| namespace | covered | total | coverage | missing |\n|---|---|---|---|---|\n")
+	for _, namespace := range r.Namespaces {
+		missing := ""
+		if len(namespace.Missing) > 0 {
+			missing = "`" + strings.Join(namespace.Missing, "`, `") + "`"
+		}
+		fmt.Fprintf(&b, "| %s | %d | %d | %.1f%% | %s |\n", namespace.Namespace, namespace.Covered, namespace.Total, namespace.Coverage, missing)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeCSV writes the report as CSV, one row per namespace followed by the total.
+func (r coverageReport) writeCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	rows := [][]string{{"namespace", "covered", "total", "coverage", "missing"}}
+	for _, namespace := range r.Namespaces {
+		rows = append(rows, []string{namespace.Namespace, strconv.Itoa(namespace.Covered), strconv.Itoa(namespace.Total), strconv.FormatFloat(namespace.Coverage, 'f', 1, 64), strings.Join(namespace.Missing, " ")})
+	}
+	rows = append(rows, []string{"total", strconv.Itoa(r.Covered), strconv.Itoa(r.Total), strconv.FormatFloat(r.Coverage, 'f', 1, 64), ""})
+	return writer.WriteAll(rows)
+}
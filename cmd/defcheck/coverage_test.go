@@ -0,0 +1,100 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aztfmod/terraform-provider-azurecaf/internal/resourcedefinition"
+)
+
+func TestServiceNamespace(t *testing.T) {
+	cases := map[string]string{
+		"azurerm_storage_account":         "storage",
+		"azurerm_key_vault_secret":        "key_vault",
+		"azurerm_linux_function_app":      "function_app",
+		"azurerm_windows_virtual_machine": "virtual_machine",
+		"azurerm_resource_group":          "resource",
+		"azurerm_bastion_host":            "bastion",
+		"azurerm_key":                     "key",
+	}
+	for resourceType, expected := range cases {
+		if namespace := serviceNamespace(resourceType); namespace != expected {
+			t.Errorf("%s: expected %s, got %s", resourceType, expected, namespace)
+		}
+	}
+}
+
+func TestCheckCoverage(t *testing.T) {
+	resourceTypes, err := readResourceList("testdata/resources.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	definitions := []resourcedefinition.ResourceStructure{
+		{ResourceTypeName: "azurerm_key_vault"},
+		{ResourceTypeName: "azurerm_storage_account"},
+		{ResourceTypeName: "azurerm_linux_function_app"},
+		{ResourceTypeName: "general"},
+	}
+
+	report := checkCoverage(definitions, resourceTypes)
+	// The duplicated storage account is counted once
+	if report.Covered != 3 || report.Total != 6 || report.Coverage != 50 {
+		t.Errorf("expected 3 of 6 types covered, got %d of %d (%v%%)", report.Covered, report.Total, report.Coverage)
+	}
+	expected := []namespaceCoverage{
+		{Namespace: "function_app", Covered: 1, Total: 2, Coverage: 50, Missing: []string{"azurerm_windows_function_app"}},
+		{Namespace: "key_vault", Covered: 1, Total: 2, Coverage: 50, Missing: []string{"azurerm_key_vault_secret"}},
+		{Namespace: "storage", Covered: 1, Total: 2, Coverage: 50, Missing: []string{"azurerm_storage_container"}},
+	}
+	if !reflect.DeepEqual(report.Namespaces, expected) {
+		t.Errorf("expected %v, got %v", expected, report.Namespaces)
+	}
+
+	var markdown, csv strings.Builder
+	if err := report.writeMarkdown(&markdown); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(markdown.String(), "| key_vault | 1 | 2 | 50.0% | `azurerm_key_vault_secret` |") {
+		t.Errorf("unexpected markdown report:\n%s", markdown.String())
+	}
+	if err := report.writeCSV(&csv); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasSuffix(csv.String(), "storage,1,2,50.0,azurerm_storage_container\ntotal,3,6,50.0,\n") {
+		t.Errorf("unexpected CSV report:\n%s", csv.String())
+	}
+}
+
+func TestRunCoverage(t *testing.T) {
+	definitions := filepath.Join(t.TempDir(), "definitions.json")
+	if err := os.WriteFile(definitions, []byte(`[{"name": "azurerm_resource_group"}, {"name": "azurerm_mssql_server"}]`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	out := filepath.Join(t.TempDir(), "coverage.json")
+
+	// testdata/schema.json has 7 nameable resources which are not deprecated
+	if err := runCoverage([]string{"-schema", "testdata/schema.json", "-definitions", definitions, "-format", "json", "-out", out, "-threshold", "25"}); err != nil {
+		t.Errorf("expected 2 of 7 types to pass the threshold, got %v", err)
+	}
+	if content, err := os.ReadFile(out); err != nil || !strings.Contains(string(content), `"coverage": 28.6`) {
+		t.Errorf("unexpected JSON report %s, %v", content, err)
+	}
+
+	err := runCoverage([]string{"-schema", "testdata/schema.json", "-definitions", definitions, "-out", out, "-threshold", "50"})
+	if err == nil || err.Error() != "coverage of 28.6% is below the threshold of 50.0%" {
+		t.Errorf("expected the threshold to fail, got %v", err)
+	}
+	// 2 of 7 is 28.57%, reported as 28.6%
+	if err := runCoverage([]string{"-schema", "testdata/schema.json", "-definitions", definitions, "-out", out, "-threshold", "28.6"}); err == nil {
+		t.Error("expected the threshold to be compared with the unrounded coverage")
+	}
+	if err := runCoverage([]string{"-schema", "testdata/schema.json", "-definitions", definitions, "-out", out, "-threshold", "28.57"}); err != nil {
+		t.Errorf("expected 28.57%% to pass the threshold, got %v", err)
+	}
+	if err := runCoverage([]string{"-schema", "testdata/schema.json", "-resources", "testdata/resources.txt"}); err == nil {
+		t.Error("expected -schema and -resources to be exclusive")
+	}
+}
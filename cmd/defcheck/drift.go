@@ -0,0 +1,280 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/aztfmod/terraform-provider-azurecaf/internal/resourcedefinition"
+)
+
+// defaultSchemaProvider is the key of the azurerm provider in the schema output.
+const defaultSchemaProvider = "registry.terraform.io/hashicorp/azurerm"
+
+// providerSchemas is the part of the `terraform providers schema -json` output read
+// by the drift check.
+type providerSchemas struct {
+	ProviderSchemas map[string]struct {
+		ResourceSchemas map[string]resourceSchema `json:"resource_schemas"`
+	} `json:"provider_schemas"`
+}
+
+type resourceSchema struct {
+	Block struct {
+		Attributes map[string]struct {
+			Required bool `json:"required"`
+			Optional bool `json:"optional"`
+		} `json:"attributes"`
+		Description string `json:"description"`
+		Deprecated  bool   `json:"deprecated"`
+	} `json:"block"`
+}
+
+// hasNameArgument reports whether the resource is configured with a name argument.
+func (r resourceSchema) hasNameArgument() bool {
+	name, exists := r.Block.Attributes["name"]
+	return exists && (name.Required || name.Optional)
+}
+
+// renamedType is an azurerm resource type with a definition, superseded by others.
+type renamedType struct {
+	From string   `json:"from"`
+	To   []string `json:"to"`
+	// Reason is deprecated when the schema names the successors of a deprecated
+	// type, similar_name when a removed type looks like types without definition
+	Reason string `json:"reason"`
+}
+
+// driftReport lists the differences between the definitions and the schema.
+type driftReport struct {
+	Provider string `json:"provider"`
+	// Missing are the resource types with a name argument and no definition
+	Missing []string `json:"missing"`
+	// Removed are the definitions whose azurerm type is not in the schema
+	Removed []string `json:"removed"`
+	// Renamed are the definitions whose azurerm type was replaced by others
+	Renamed []renamedType `json:"renamed"`
+}
+
+func runDrift(args []string) error {
+	flags := newFlagSet("drift", "-schema <file> [flags]")
+	schemaPath := flags.String("schema", "", "cached output of `terraform providers schema -json`, see scripts/mock-test/fetch_schema.sh (required)")
+	definitionsPath := flags.String("definitions", "resourceDefinition.json", "resource definitions to check")
+	provider := flags.String("provider", defaultSchemaProvider, "key of the azurerm provider in the schema")
+	format := flags.String("format", "markdown", "output format: markdown or json")
+	out := flags.String("out", "", "file the report is written to, defaults to the standard output")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *schemaPath == "" {
+		flags.Usage()
+		return fmt.Errorf("-schema is required")
+	}
+	if err := checkFormat(*format, "markdown", "json"); err != nil {
+		return err
+	}
+
+	definitions, err := resourcedefinition.Read(*definitionsPath)
+	if err != nil {
+		return err
+	}
+	resources, err := readResourceSchemas(*schemaPath, *provider)
+	if err != nil {
+		return err
+	}
+	report := checkDrift(definitions, resources)
+	report.Provider = *provider
+
+	return writeOutput(*out, func(w io.Writer) error {
+		if *format == "json" {
+			encoder := json.NewEncoder(w)
+			encoder.SetIndent("", "  ")
+			return encoder.Encode(report)
+		}
+		return report.writeMarkdown(w)
+	})
+}
+
+// readResourceSchemas reads the resource schemas of a provider from a schema file.
+func readResourceSchemas(path string, provider string) (map[string]resourceSchema, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var schemas providerSchemas
+	if err := json.Unmarshal(content, &schemas); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	schema, exists := schemas.ProviderSchemas[provider]
+	if !exists {
+		providers := []string{}
+		for key := range schemas.ProviderSchemas {
+			providers = append(providers, key)
+		}
+		sort.Strings(providers)
+		return nil, fmt.Errorf("%s has no schema for the provider %s, found: [%s]", path, provider, strings.Join(providers, ", "))
+	}
+	return schema.ResourceSchemas, nil
+}
+
+var azurermTypeRegEx = regexp.MustCompile(`azurerm_[a-z0-9_]*[a-z0-9]`)
+
+// checkDrift compares the azurerm definitions with the resource schemas. The
+// definitions of other providers, e.g. general or databricks_cluster, are ignored.
+func checkDrift(definitions []resourcedefinition.ResourceStructure, resources map[string]resourceSchema) driftReport {
+	defined := map[string]bool{}
+	for _, d := range definitions {
+		defined[d.ResourceTypeName] = true
+	}
+
+	report := driftReport{Missing: []string{}, Removed: []string{}, Renamed: []renamedType{}}
+
+	// Deprecated types whose description names their successors
+	for resourceType, resource := range resources {
+		if !defined[resourceType] || !resource.Block.Deprecated {
+			continue
+		}
+		successors := []string{}
+		for _, successor := range azurermTypeRegEx.FindAllString(resource.Block.Description, -1) {
+			if _, exists := resources[successor]; exists && successor != resourceType && !slices.Contains(successors, successor) {
+				successors = append(successors, successor)
+			}
+		}
+		if len(successors) > 0 {
+			sort.Strings(successors)
+			report.Renamed = append(report.Renamed, renamedType{From: resourceType, To: successors, Reason: "deprecated"})
+		}
+	}
+
+	missing := []string{}
+	for resourceType, resource := range resources {
+		if !defined[resourceType] && !resource.Block.Deprecated && resource.hasNameArgument() {
+			missing = append(missing, resourceType)
+		}
+	}
+	sort.Strings(missing)
+
+	removed := []string{}
+	for _, d := range definitions {
+		if _, exists := resources[d.ResourceTypeName]; !exists && strings.HasPrefix(d.ResourceTypeName, "azurerm_") {
+			removed = append(removed, d.ResourceTypeName)
+		}
+	}
+	sort.Strings(removed)
+
+	// Removed types looking like types without definition
+	claimed := map[string]bool{}
+	for _, from := range removed {
+		successors := similarTypes(from, missing)
+		if len(successors) == 0 {
+			report.Removed = append(report.Removed, from)
+			continue
+		}
+		report.Renamed = append(report.Renamed, renamedType{From: from, To: successors, Reason: "similar_name"})
+		for _, successor := range successors {
+			claimed[successor] = true
+		}
+	}
+	for _, resourceType := range missing {
+		if !claimed[resourceType] {
+			report.Missing = append(report.Missing, resourceType)
+		}
+	}
+	sort.Slice(report.Renamed, func(i, j int) bool { return report.Renamed[i].From < report.Renamed[j].From })
+	return report
+}
+
+// platformTokens are dropped before comparing type names, azurerm splits many types
+// into a Linux and a Windows one.
+var platformTokens = map[string]bool{"linux": true, "windows": true}
+
+// normalizeTypeName returns the name of an azurerm type without the provider prefix
+// and the platform tokens.
+func normalizeTypeName(resourceType string) string {
+	tokens := []string{}
+	for _, token := range strings.Split(strings.TrimPrefix(resourceType, "azurerm_"), "_") {
+		if !platformTokens[token] {
+			tokens = append(tokens, token)
+		}
+	}
+	return strings.Join(tokens, "_")
+}
+
+// similarTypes returns the candidates whose normalized name is the one of
+// resourceType, contains it with at most 3 more characters, e.g. sql_server and
+// mssql_server, or is at most 2 edits away from it.
+func similarTypes(resourceType string, candidates []string) []string {
+	name := normalizeTypeName(resourceType)
+	similar := []string{}
+	for _, candidate := range candidates {
+		other := normalizeTypeName(candidate)
+		switch {
+		case name == other:
+		case (strings.Contains(other, name) || strings.Contains(name, other)) && abs(len(other)-len(name)) <= 3:
+		case len(name) >= 8 && levenshtein(name, other) <= 2:
+		default:
+			continue
+		}
+		similar = append(similar, candidate)
+	}
+	return similar
+}
+
+// levenshtein returns the edit distance between two strings.
+func levenshtein(a string, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// writeMarkdown writes the report as markdown sections.
+func (r driftReport) writeMarkdown(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Resource definition drift\n\nCompared with the schema of %s.\n\n", r.Provider)
+
+	fmt.Fprintf(&b, "## Missing definitions (%d)\n\nResources with a `name` argument and no entry in resourceDefinition.json.\n\n", len(r.Missing))
+	for _, resourceType := range r.Missing {
+		fmt.Fprintf(&b, "- `%s`\n", resourceType)
+	}
+
+	fmt.Fprintf(&b, "\n## Removed types (%d)\n\nDefinitions whose azurerm type is not in the schema.\n\n", len(r.Removed))
+	for _, resourceType := range r.Removed {
+		fmt.Fprintf(&b, "- `%s`\n", resourceType)
+	}
+
+	fmt.Fprintf(&b, "\n## Renamed types (%d)\n\n", len(r.Renamed))
+	if len(r.Renamed) > 0 {
+		b.WriteString("| definition | replaced by | reason |\n|---|---|---|\n")
+		for _, rename := range r.Renamed {
+			fmt.Fprintf(&b, "| `%s` | `%s` | %s |\n", rename.From, strings.Join(rename.To, "`, `"), rename.Reason)
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
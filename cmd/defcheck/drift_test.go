@@ -0,0 +1,75 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aztfmod/terraform-provider-azurecaf/internal/resourcedefinition"
+)
+
+func TestCheckDrift(t *testing.T) {
+	resources, err := readResourceSchemas("testdata/schema.json", defaultSchemaProvider)
+	if err != nil {
+		t.Fatal(err)
+	}
+	definitions := []resourcedefinition.ResourceStructure{
+		{ResourceTypeName: "azurerm_resource_group"},
+		{ResourceTypeName: "azurerm_storage_account"},
+		{ResourceTypeName: "azurerm_app_service"},
+		{ResourceTypeName: "azurerm_linux_web_app"},
+		{ResourceTypeName: "azurerm_sql_server"},
+		{ResourceTypeName: "azurerm_batch_certificate"},
+		{ResourceTypeName: "general"},
+	}
+
+	report := checkDrift(definitions, resources)
+	// Resources without a name argument and deprecated ones are not missing, and the
+	// successor of a removed type is reported as its rename
+	if expected := []string{"azurerm_contoso_widget", "azurerm_role_assignment", "azurerm_windows_web_app"}; !reflect.DeepEqual(report.Missing, expected) {
+		t.Errorf("expected missing %v, got %v", expected, report.Missing)
+	}
+	if expected := []string{"azurerm_batch_certificate"}; !reflect.DeepEqual(report.Removed, expected) {
+		t.Errorf("expected removed %v, got %v", expected, report.Removed)
+	}
+	expected := []renamedType{
+		{From: "azurerm_app_service", To: []string{"azurerm_linux_web_app", "azurerm_windows_web_app"}, Reason: "deprecated"},
+		{From: "azurerm_sql_server", To: []string{"azurerm_mssql_server"}, Reason: "similar_name"},
+	}
+	if !reflect.DeepEqual(report.Renamed, expected) {
+		t.Errorf("expected renamed %v, got %v", expected, report.Renamed)
+	}
+
+	var markdown strings.Builder
+	if err := report.writeMarkdown(&markdown); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(markdown.String(), "## Missing definitions (3)") || !strings.Contains(markdown.String(), "| `azurerm_sql_server` | `azurerm_mssql_server` | similar_name |") {
+		t.Errorf("unexpected markdown report:\n%s", markdown.String())
+	}
+}
+
+func TestReadResourceSchemas_UnknownProvider(t *testing.T) {
+	_, err := readResourceSchemas("testdata/schema.json", "registry.terraform.io/hashicorp/azuread")
+	if err == nil || !strings.Contains(err.Error(), "found: [registry.terraform.io/hashicorp/azurerm]") {
+		t.Errorf("expected the available providers in the error, got %v", err)
+	}
+}
+
+func TestSimilarTypes(t *testing.T) {
+	cases := []struct {
+		resourceType string
+		candidates   []string
+		expected     []string
+	}{
+		{"azurerm_virtual_machine", []string{"azurerm_linux_virtual_machine", "azurerm_windows_virtual_machine"}, []string{"azurerm_linux_virtual_machine", "azurerm_windows_virtual_machine"}},
+		{"azurerm_sql_server", []string{"azurerm_mssql_server", "azurerm_sql_managed_instance"}, []string{"azurerm_mssql_server"}},
+		{"azurerm_api_management_property", []string{"azurerm_api_management_named_value"}, []string{}},
+		{"azurerm_monitor_diagnostic_setting", []string{"azurerm_monitor_diagnostics_setting"}, []string{"azurerm_monitor_diagnostics_setting"}},
+	}
+	for _, tt := range cases {
+		if similar := similarTypes(tt.resourceType, tt.candidates); !reflect.DeepEqual(similar, tt.expected) {
+			t.Errorf("%s: expected %v, got %v", tt.resourceType, tt.expected, similar)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+// Command defcheck cross-checks resourceDefinition.json against other sources of
+// Azure resource types, offline.
+//
+// Usage:
+//
+//	go run ./cmd/defcheck coverage [-resources list.txt | -schema azurerm-schema.json] [-format markdown|json|csv] [-threshold 80]
+//	go run ./cmd/defcheck drift -schema azurerm-schema.json [-format markdown|json] [-out report.md]
+//
+// The coverage subcommand reports the share of the azurerm resource types, read
+// from a list or from a provider schema, defined in resourceDefinition.json, overall
+// and by service namespace. It fails when the overall coverage is below -threshold.
+//
+// The drift subcommand reads a cached `terraform providers schema -json` output, as
+// written by scripts/mock-test/fetch_schema.sh, and reports the azurerm resources
+// without a definition, the definitions whose azurerm type no longer exists and the
+// renamed types.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage(os.Stderr)
+		os.Exit(2)
+	}
+	var err error
+	switch os.Args[1] {
+	case "drift":
+		err = runDrift(os.Args[2:])
+	case "coverage":
+		err = runCoverage(os.Args[2:])
+	case "-h", "-help", "--help", "help":
+		usage(os.Stdout)
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "unknown subcommand %q\n", os.Args[1])
+		usage(os.Stderr)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "defcheck %s: %s\n", os.Args[1], err)
+		os.Exit(1)
+	}
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: defcheck <subcommand> [flags]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Subcommands:")
+	fmt.Fprintln(w, "  coverage  report the azurerm resource types defined in resourceDefinition.json, by service namespace")
+	fmt.Fprintln(w, "  drift     compare resourceDefinition.json with a cached azurerm provider schema")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Run defcheck <subcommand> -h for the flags of a subcommand.")
+}
+
+// writeOutput writes a report to path, or to the standard output when path is empty.
+func writeOutput(path string, write func(io.Writer) error) error {
+	if path == "" {
+		return write(os.Stdout)
+	}
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := write(file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// checkFormat validates the value of a -format flag.
+func checkFormat(format string, formats ...string) error {
+	for _, f := range formats {
+		if format == f {
+			return nil
+		}
+	}
+	return fmt.Errorf("-format must be one of %s, got %q", strings.Join(formats, ", "), format)
+}
+
+// newFlagSet returns the flag set of a subcommand, whose usage lists its flags.
+func newFlagSet(name string, synopsis string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: defcheck %s %s\n\n", name, synopsis)
+		flags.PrintDefaults()
+	}
+	return flags
+}
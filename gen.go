@@ -20,85 +20,33 @@
 package main
 
 import (
-	"encoding/json"
 	"fmt"
 	"log"
 	"os"
 	"path"
 	"regexp"
 	"sort"
+	"strconv"
+	"strings"
 	"text/template"
-)
-
-// OfficialData defines the official Azure CAF documentation attributes for a resource
-type OfficialData struct {
-	// Slug is the official CAF abbreviation for this resource type
-	// Only present for resources that are in the official Azure CAF documentation
-	Slug string `json:"slug,omitempty"`
-
-	// Resource is the official resource name from Azure CAF documentation
-	Resource string `json:"resource"`
-
-	// ResourceProviderNamespace is the Azure resource provider namespace from official documentation
-	// Only present for resources that are in the official Azure CAF documentation
-	ResourceProviderNamespace string `json:"resource_provider_namespace,omitempty"`
-}
-
-// ResourceStructure defines the schema for Azure resource naming requirements
-// as specified in the resourceDefinition.json file.
-//
-// Each resource type has specific constraints that must be enforced when
-// generating compliant names for Azure resources.
-type ResourceStructure struct {
-	// ResourceTypeName is the full Terraform resource type name (e.g., "azurerm_storage_account")
-	ResourceTypeName string `json:"name"`
-
-	// CafPrefix is the Cloud Adoption Framework abbreviation for this resource type (e.g., "st" for storage account)
-	// This slug is used as a prefix in generated names to indicate resource type
-	CafPrefix string `json:"slug,omitempty"`
-
-	// MinLength defines the minimum allowed length for the resource name
-	MinLength int `json:"min_length"`
-
-	// MaxLength defines the maximum allowed length for the resource name
-	MaxLength int `json:"max_length"`
-
-	// LowerCase indicates whether the resource name must be entirely lowercase
-	LowerCase bool `json:"lowercase,omitempty"`
-
-	// RegEx is the cleaning regex pattern used to remove invalid characters from input names
-	// Characters matching this pattern will be stripped from the name
-	RegEx string `json:"regex,omitempty"`
-
-	// ValidationRegExp is the validation regex that the final generated name must match
-	// This ensures the generated name complies with Azure's naming requirements
-	ValidationRegExp string `json:"validation_regex,omitempty"`
-
-	// Dashes indicates whether the resource type allows dash characters in names
-	Dashes bool `json:"dashes"`
-
-	// Scope defines where the resource name must be unique (e.g., "global", "resourceGroup", "parent")
-	Scope string `json:"scope,omitempty"`
 
-	// OutOfDoc indicates whether this resource is not present in the official Azure CAF documentation
-	OutOfDoc bool `json:"out_of_doc,omitempty"`
-
-	// Official contains the official Azure CAF documentation attributes for this resource
-	Official OfficialData `json:"official"`
-}
+	"github.com/aztfmod/terraform-provider-azurecaf/internal/resourcedefinition"
+)
 
 // templateData holds the data structure passed to the Go template for code generation
 type templateData struct {
-	ResourceStructures []ResourceStructure // All resource definitions from JSON
-	SlugMap            map[string]string   // Mapping of CAF prefixes to resource types
+	ResourceStructures []resourcedefinition.ResourceStructure // All resource definitions from JSON
+	SlugMap            map[string]string                      // Mapping of CAF prefixes and aliases to resource types
+	AmbiguousSlugs     map[string][]string                    // Slugs shared by several resource types without a canonical one
 }
 
 // main is the entry point for the code generator.
 // It performs the following steps:
 //  1. Reads resource definitions from resourceDefinition.json
-//  2. Loads and parses Go templates from the templates/ directory
-//  3. Processes the resource data to create mappings and deduplicate entries
-//  4. Generates models_generated.go with all resource definitions and validation logic
+//  2. Validates every definition and stops with a per-entry report on errors
+//  3. Loads and parses Go templates from the templates/ directory
+//  4. Processes the resource data to create mappings and deduplicate entries
+//  5. Generates models_generated.go with all resource definitions and validation logic
 func main() {
 	// Get the current working directory to locate input files
 	wd, err := os.Getwd()
@@ -136,16 +84,18 @@ func main() {
 
 	// Read the combined resource definitions from JSON file
 	// This file now contains both documented and undocumented resources
-	sourceDefinitions, err := os.ReadFile(path.Join(wd, "resourceDefinition.json"))
+	uniqueData, err := resourcedefinition.Read(path.Join(wd, "resourceDefinition.json"))
 	if err != nil {
 		log.Fatal(err)
 	}
 
-	// Parse JSON resource definitions into Go structs
-	var uniqueData []ResourceStructure
-	err = json.Unmarshal(sourceDefinitions, &uniqueData)
-	if err != nil {
-		log.Fatal(err)
+	// Refuse to generate anything from an invalid definition
+	report := validateDefinitions(uniqueData)
+	for _, warning := range report.Warnings {
+		log.Printf("warning: %s", warning)
+	}
+	if len(report.Errors) > 0 {
+		log.Fatalf("resourceDefinition.json has %d invalid definitions:\n%s", len(report.Errors), report.String())
 	}
 
 	// Sort by resource type name for consistent output
@@ -153,14 +103,9 @@ func main() {
 		return uniqueData[i].ResourceTypeName < uniqueData[j].ResourceTypeName
 	})
 
-	// Build a mapping of CAF prefixes (slugs) to resource types
+	// Build a mapping of CAF prefixes (slugs) and aliases to resource types
 	// This allows reverse lookup from slug to resource type name
-	slugMap := make(map[string]string)
-	for _, res := range uniqueData {
-		if _, exists := slugMap[res.CafPrefix]; !exists {
-			slugMap[res.CafPrefix] = res.ResourceTypeName
-		}
-	}
+	slugMap, ambiguousSlugs := resourcedefinition.ResolveSlugs(slugClaims(uniqueData))
 
 	// Generate the Go source file using the parsed template
 	modelsFile, err := os.OpenFile(path.Join(wd, "azurecaf/models_generated.go"), os.O_TRUNC|os.O_CREATE|os.O_WRONLY, 0644)
@@ -173,6 +118,7 @@ func main() {
 	err = parsedTemplate.ExecuteTemplate(modelsFile, "model.tmpl", templateData{
 		ResourceStructures: uniqueData,
 		SlugMap:            slugMap,
+		AmbiguousSlugs:     ambiguousSlugs,
 	})
 
 	if err != nil {
@@ -180,3 +126,125 @@ func main() {
 	}
 	log.Println("File generated")
 }
+
+// validationReport lists the problems found in the resource definitions.
+type validationReport struct {
+	// Errors maps an entry, e.g. "azurerm_storage_account (entry 12)", to its problems
+	Errors map[string][]string
+	// Warnings are reported without failing the generation
+	Warnings []string
+}
+
+// String renders the errors sorted by entry.
+func (r validationReport) String() string {
+	entries := make([]string, 0, len(r.Errors))
+	for entry := range r.Errors {
+		entries = append(entries, entry)
+	}
+	sort.Strings(entries)
+	var report strings.Builder
+	for _, entry := range entries {
+		fmt.Fprintf(&report, "  %s:\n", entry)
+		for _, problem := range r.Errors[entry] {
+			fmt.Fprintf(&report, "    - %s\n", problem)
+		}
+	}
+	return report.String()
+}
+
+// validateDefinitions checks every definition before it is turned into Go code:
+//
+//   - regex and validation_regex are Go string literals
+//
+//   - the unquoted definition passes resourcedefinition.Validate, the rules the
+//     provider applies to custom definitions
+//
+//   - resource type names are unique
+//
+//   - a slug is claimed by at most one canonical resource type
+//
+// Slugs shared by several resource types without a canonical one are reported as
+// warnings, looking them up fails with the list of candidates.
+func validateDefinitions(definitions []resourcedefinition.ResourceStructure) validationReport {
+	report := validationReport{Errors: map[string][]string{}}
+	names := map[string]int{}
+
+	for i, definition := range definitions {
+		entry := fmt.Sprintf("%s (entry %d)", definition.ResourceTypeName, i)
+		problems := []string{}
+
+		if first, exists := names[definition.ResourceTypeName]; exists {
+			problems = append(problems, fmt.Sprintf("name is already used by entry %d", first))
+		} else if definition.ResourceTypeName != "" {
+			names[definition.ResourceTypeName] = i
+		}
+
+		// The other rules are only checked once the patterns unquote, a quoted
+		// pattern would be reported again as not compiling
+		unquoted := definition
+		var regexErr, validationErr error
+		if unquoted.RegEx, regexErr = unquoteDefinitionPattern(definition.RegEx); regexErr != nil {
+			problems = append(problems, fmt.Sprintf("regex: %v", regexErr))
+		}
+		if unquoted.ValidationRegExp, validationErr = unquoteDefinitionPattern(definition.ValidationRegExp); validationErr != nil {
+			problems = append(problems, fmt.Sprintf("validation_regex: %v", validationErr))
+		}
+		if regexErr == nil && validationErr == nil {
+			problems = append(problems, resourcedefinition.Validate(unquoted)...)
+		}
+
+		if len(problems) > 0 {
+			report.Errors[entry] = problems
+		}
+	}
+
+	claims := resourcedefinition.ClaimsBySlug(slugClaims(definitions))
+	sharedSlugs := make([]string, 0, len(claims))
+	for slug, claimants := range claims {
+		if len(claimants) > 1 {
+			sharedSlugs = append(sharedSlugs, slug)
+		}
+	}
+	sort.Strings(sharedSlugs)
+	for _, slug := range sharedSlugs {
+		canonical := []string{}
+		resourceTypes := make([]string, len(claims[slug]))
+		for i, claimant := range claims[slug] {
+			resourceTypes[i] = claimant.ResourceType
+			if claimant.Canonical {
+				canonical = append(canonical, claimant.ResourceType)
+			}
+		}
+		switch len(canonical) {
+		case 0:
+			report.Warnings = append(report.Warnings, fmt.Sprintf("slug %q is shared by %s without a canonical resource type, looking it up fails", slug, strings.Join(resourceTypes, ", ")))
+		case 1:
+		default:
+			entry := fmt.Sprintf("slug %s", slug)
+			report.Errors[entry] = append(report.Errors[entry], fmt.Sprintf("several canonical resource types: %s", strings.Join(canonical, ", ")))
+		}
+	}
+	return report
+}
+
+// slugClaims returns the claims of the slugs and slug aliases of the definitions.
+func slugClaims(definitions []resourcedefinition.ResourceStructure) []resourcedefinition.SlugClaim {
+	claims := []resourcedefinition.SlugClaim{}
+	for _, definition := range definitions {
+		claims = append(claims, definition.SlugClaims()...)
+	}
+	return claims
+}
+
+// unquoteDefinitionPattern unquotes a pattern stored as a Go string literal, an
+// empty pattern is left to resourcedefinition.Validate.
+func unquoteDefinitionPattern(pattern string) (string, error) {
+	if pattern == "" {
+		return "", nil
+	}
+	unquoted, err := strconv.Unquote(pattern)
+	if err != nil {
+		return pattern, fmt.Errorf("%s is not a Go string literal: %v", pattern, err)
+	}
+	return unquoted, nil
+}
@@ -0,0 +1,89 @@
+// Package resourcedefinition holds the model of resourceDefinition.json shared by the
+// code generator, gen.go, and the maintenance commands of cmd/defcheck.
+//
+// The provider itself uses the ResourceStructure of the azurecaf package, generated
+// from these definitions, and shares the slug resolution and the validation of the
+// definitions with the code generator.
+package resourcedefinition
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// OfficialData defines the official Azure CAF documentation attributes for a resource
+type OfficialData struct {
+	// Slug is the official CAF abbreviation for this resource type
+	// Only present for resources that are in the official Azure CAF documentation
+	Slug string `json:"slug,omitempty"`
+
+	// Resource is the official resource name from Azure CAF documentation
+	Resource string `json:"resource"`
+
+	// ResourceProviderNamespace is the Azure resource provider namespace from official documentation
+	// Only present for resources that are in the official Azure CAF documentation
+	ResourceProviderNamespace string `json:"resource_provider_namespace,omitempty"`
+}
+
+// ResourceStructure defines the schema for Azure resource naming requirements
+// as specified in the resourceDefinition.json file.
+//
+// Each resource type has specific constraints that must be enforced when
+// generating compliant names for Azure resources.
+type ResourceStructure struct {
+	// ResourceTypeName is the full Terraform resource type name (e.g., "azurerm_storage_account")
+	ResourceTypeName string `json:"name"`
+
+	// CafPrefix is the Cloud Adoption Framework abbreviation for this resource type (e.g., "st" for storage account)
+	// This slug is used as a prefix in generated names to indicate resource type
+	CafPrefix string `json:"slug,omitempty"`
+
+	// MinLength defines the minimum allowed length for the resource name
+	MinLength int `json:"min_length"`
+
+	// MaxLength defines the maximum allowed length for the resource name
+	MaxLength int `json:"max_length"`
+
+	// LowerCase indicates whether the resource name must be entirely lowercase
+	LowerCase bool `json:"lowercase,omitempty"`
+
+	// RegEx is the cleaning regex pattern used to remove invalid characters from input names
+	// Characters matching this pattern will be stripped from the name
+	RegEx string `json:"regex,omitempty"`
+
+	// ValidationRegExp is the validation regex that the final generated name must match
+	// This ensures the generated name complies with Azure's naming requirements
+	ValidationRegExp string `json:"validation_regex,omitempty"`
+
+	// Dashes indicates whether the resource type allows dash characters in names
+	Dashes bool `json:"dashes"`
+
+	// Scope defines where the resource name must be unique (e.g., "global", "resourceGroup", "parent")
+	Scope string `json:"scope,omitempty"`
+
+	// OutOfDoc indicates whether this resource is not present in the official Azure CAF documentation
+	OutOfDoc bool `json:"out_of_doc,omitempty"`
+
+	// Official contains the official Azure CAF documentation attributes for this resource
+	Official OfficialData `json:"official"`
+
+	// SlugAliases are additional slugs looking up this resource type, they are never part of the names
+	SlugAliases []string `json:"slug_aliases,omitempty"`
+
+	// Canonical marks the resource type its slug and aliases resolve to when other types share them
+	Canonical bool `json:"canonical,omitempty"`
+}
+
+// Read reads the resource definitions of a file in the format of resourceDefinition.json.
+func Read(path string) ([]ResourceStructure, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var definitions []ResourceStructure
+	if err := json.Unmarshal(content, &definitions); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return definitions, nil
+}
@@ -0,0 +1,64 @@
+package resourcedefinition
+
+import "sort"
+
+// SlugClaim is a slug, or a slug alias, claimed by a resource type.
+type SlugClaim struct {
+	Slug         string
+	ResourceType string
+	// Canonical claims win over the other claims of the same slug
+	Canonical bool
+}
+
+// SlugClaims returns the claims of the slug and the slug aliases of a definition.
+// The empty slug is claimed by the definitions without a slug.
+func (r ResourceStructure) SlugClaims() []SlugClaim {
+	claims := []SlugClaim{}
+	for _, slug := range append([]string{r.CafPrefix}, r.SlugAliases...) {
+		claims = append(claims, SlugClaim{Slug: slug, ResourceType: r.ResourceTypeName, Canonical: r.Canonical})
+	}
+	return claims
+}
+
+// ClaimsBySlug groups the claims by slug, each group sorted by resource type.
+func ClaimsBySlug(claims []SlugClaim) map[string][]SlugClaim {
+	sorted := append([]SlugClaim{}, claims...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].ResourceType < sorted[j].ResourceType
+	})
+	grouped := map[string][]SlugClaim{}
+	for _, claim := range sorted {
+		grouped[claim.Slug] = append(grouped[claim.Slug], claim)
+	}
+	return grouped
+}
+
+// ResolveSlugs maps every slug claimed by a single resource type, or by a single
+// canonical one, to that type. The other slugs are ambiguous and returned with the
+// resource types claiming them, sorted.
+//
+// The code generator resolves the slugs of resourceDefinition.json with it, and the
+// provider the slugs of the custom definitions, so both resolve them the same way.
+func ResolveSlugs(claims []SlugClaim) (map[string]string, map[string][]string) {
+	slugs := map[string]string{}
+	ambiguousSlugs := map[string][]string{}
+	for slug, claimants := range ClaimsBySlug(claims) {
+		canonical := []string{}
+		resourceTypes := make([]string, len(claimants))
+		for i, claimant := range claimants {
+			resourceTypes[i] = claimant.ResourceType
+			if claimant.Canonical {
+				canonical = append(canonical, claimant.ResourceType)
+			}
+		}
+		switch {
+		case len(resourceTypes) == 1:
+			slugs[slug] = resourceTypes[0]
+		case len(canonical) == 1:
+			slugs[slug] = canonical[0]
+		default:
+			ambiguousSlugs[slug] = resourceTypes
+		}
+	}
+	return slugs, ambiguousSlugs
+}
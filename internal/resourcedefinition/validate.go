@@ -0,0 +1,146 @@
+package resourcedefinition
+
+import (
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strconv"
+)
+
+// Validate checks a definition whose regex and validation_regex are plain patterns,
+// not Go string literals, and returns its problems:
+//
+//   - the name is not empty
+//
+//   - min_length and max_length are positive and min_length <= max_length
+//
+//   - regex compiles with RE2 and does not remove lowercase letters
+//
+//   - validation_regex compiles with RE2, can match names of a length in the
+//     min_length..max_length range and agrees with dashes
+//
+//   - slug aliases are not empty and differ from the slug
+//
+// The code generator checks the definitions of resourceDefinition.json with it, and
+// the provider the custom definitions, so both apply the same rules.
+func Validate(definition ResourceStructure) []string {
+	problems := []string{}
+	if definition.ResourceTypeName == "" {
+		problems = append(problems, "name is empty")
+	}
+	if definition.MinLength < 1 {
+		problems = append(problems, fmt.Sprintf("min_length %d must be at least 1", definition.MinLength))
+	}
+	if definition.MinLength > definition.MaxLength {
+		problems = append(problems, fmt.Sprintf("min_length %d exceeds max_length %d", definition.MinLength, definition.MaxLength))
+	}
+
+	if cleaning, _, err := compilePattern(definition.RegEx); err != nil {
+		problems = append(problems, fmt.Sprintf("regex: %v", err))
+	} else if cleaning.ReplaceAllString("abcde", "") != "abcde" {
+		problems = append(problems, fmt.Sprintf("regex %s must not remove lowercase letters", definition.RegEx))
+	}
+
+	if validation, parsed, err := compilePattern(definition.ValidationRegExp); err != nil {
+		problems = append(problems, fmt.Sprintf("validation_regex: %v", err))
+	} else {
+		if minimum, maximum := matchLengthRange(parsed); definition.MinLength <= definition.MaxLength && (minimum > definition.MaxLength || (maximum >= 0 && maximum < definition.MinLength)) {
+			problems = append(problems, fmt.Sprintf("validation_regex matches names of %s characters, which never fit in the %d..%d length range", formatLengthRange(minimum, maximum), definition.MinLength, definition.MaxLength))
+		}
+		if validation.MatchString("aaa-aaa") != definition.Dashes {
+			problems = append(problems, fmt.Sprintf("dashes is %t but validation_regex %s disagrees", definition.Dashes, definition.ValidationRegExp))
+		}
+	}
+
+	for _, alias := range definition.SlugAliases {
+		if alias == "" {
+			problems = append(problems, "slug_aliases contains an empty alias")
+		} else if alias == definition.CafPrefix {
+			problems = append(problems, fmt.Sprintf("slug alias %q is the slug itself", alias))
+		}
+	}
+	return problems
+}
+
+// compilePattern compiles a pattern with RE2 and parses it for matchLengthRange.
+func compilePattern(pattern string) (*regexp.Regexp, *syntax.Regexp, error) {
+	if pattern == "" {
+		return nil, nil, fmt.Errorf("is empty")
+	}
+	compiled, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s does not compile: %v", pattern, err)
+	}
+	parsed, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s does not compile: %v", pattern, err)
+	}
+	return compiled, parsed.Simplify(), nil
+}
+
+// matchLengthRange returns the minimum and maximum number of characters of the
+// strings a regular expression matches, the maximum is -1 when unbounded.
+func matchLengthRange(re *syntax.Regexp) (int, int) {
+	switch re.Op {
+	case syntax.OpNoMatch:
+		return 1 << 30, 0
+	case syntax.OpLiteral:
+		return len(re.Rune), len(re.Rune)
+	case syntax.OpCharClass, syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		return 1, 1
+	case syntax.OpCapture:
+		return matchLengthRange(re.Sub[0])
+	case syntax.OpStar:
+		return 0, -1
+	case syntax.OpPlus:
+		minimum, _ := matchLengthRange(re.Sub[0])
+		return minimum, -1
+	case syntax.OpQuest:
+		_, maximum := matchLengthRange(re.Sub[0])
+		return 0, maximum
+	case syntax.OpRepeat:
+		minimum, maximum := matchLengthRange(re.Sub[0])
+		if re.Max < 0 || maximum < 0 {
+			return minimum * re.Min, -1
+		}
+		return minimum * re.Min, maximum * re.Max
+	case syntax.OpConcat:
+		minimum, maximum := 0, 0
+		for _, sub := range re.Sub {
+			subMinimum, subMaximum := matchLengthRange(sub)
+			minimum += subMinimum
+			if maximum >= 0 {
+				if subMaximum < 0 {
+					maximum = -1
+				} else {
+					maximum += subMaximum
+				}
+			}
+		}
+		return minimum, maximum
+	case syntax.OpAlternate:
+		minimum, maximum := matchLengthRange(re.Sub[0])
+		for _, sub := range re.Sub[1:] {
+			subMinimum, subMaximum := matchLengthRange(sub)
+			if subMinimum < minimum {
+				minimum = subMinimum
+			}
+			if maximum >= 0 && (subMaximum < 0 || subMaximum > maximum) {
+				maximum = subMaximum
+			}
+		}
+		return minimum, maximum
+	}
+	// Empty matches and assertions such as ^ and $ do not consume characters
+	return 0, 0
+}
+
+func formatLengthRange(minimum, maximum int) string {
+	if maximum < 0 {
+		return fmt.Sprintf("%d or more", minimum)
+	}
+	if minimum == maximum {
+		return strconv.Itoa(minimum)
+	}
+	return fmt.Sprintf("%d to %d", minimum, maximum)
+}
@@ -13,9 +13,11 @@
 package main
 
 import (
+	"context"
+	"log"
+
 	"github.com/aztfmod/terraform-provider-azurecaf/azurecaf"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5/tf5server"
 )
 
 // go:generate directive runs the code generation tool to create resource definitions
@@ -23,13 +25,19 @@ import (
 // resource types and their naming constraints are up-to-date.
 //go:generate go run gen.go
 
-// main initializes and serves the Terraform provider using the Terraform plugin SDK.
-// The provider is configured through the azurecaf.Provider() function which defines
-// the available resources and data sources.
+// main initializes and serves the Terraform provider. The resources and data sources
+// are defined by the azurecaf.Provider() function using the Terraform plugin SDK and
+// are muxed with the provider-defined functions by azurecaf.ProtocolServer().
 func main() {
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: func() *schema.Provider {
-			return azurecaf.Provider()
-		},
-	})
+	ctx := context.Background()
+
+	serverFactory, err := azurecaf.ProtocolServer(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = tf5server.Serve(azurecaf.ProviderAddress, serverFactory)
+	if err != nil {
+		log.Fatal(err)
+	}
 }